@@ -0,0 +1,77 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+
+	"github.com/confa-chat/node/pkg/uuid"
+	"github.com/confa-chat/node/src/confa"
+	"github.com/confa-chat/node/src/config"
+	"github.com/confa-chat/node/src/store"
+	"github.com/confa-chat/node/src/store/attachment"
+)
+
+// runExport implements the export subcommand, which writes the archive of a channel to a file
+func runExport(args []string) {
+	flags := flag.NewFlagSet("export", flag.ExitOnError)
+	configFilePath := flags.String("config", "", "Path to YAML configuration file")
+	serverIDFlag := flags.String("server", "", "ID of the server of the channel")
+	channelIDFlag := flags.String("channel", "", "ID of the channel to export")
+	outputPath := flags.String("out", "", "Path of the zip archive, defaults to <channel id>.zip")
+	flags.Parse(args)
+
+	serverID, err := uuid.FromString(*serverIDFlag)
+	if err != nil {
+		log.Fatalf("invalid server ID: %v", err)
+	}
+	channelID, err := uuid.FromString(*channelIDFlag)
+	if err != nil {
+		log.Fatalf("invalid channel ID: %v", err)
+	}
+	if *outputPath == "" {
+		*outputPath = channelID.String() + ".zip"
+	}
+
+	ctx := context.Background()
+
+	cfg, err := config.Load(*configFilePath)
+	if err != nil {
+		log.Fatalf("error loading config: %v", err)
+	}
+
+	db, dbpool, err := store.ConnectPostgres(ctx, cfg.DB)
+	if err != nil {
+		log.Fatalf("error connecting to the database: %v", err)
+	}
+
+	attachStorage, err := attachment.NewStorageFromConfig(&cfg.AttachmentConfig)
+	if err != nil {
+		log.Fatalf("error initializing attachment storage: %v", err)
+	}
+
+	srv := confa.NewService(db, dbpool, cfg, attachStorage)
+
+	// Write to a temporary file, so a failed export doesn't leave a truncated archive behind
+	tmpPath := *outputPath + ".part"
+	f, err := os.Create(tmpPath)
+	if err != nil {
+		log.Fatalf("error creating the archive: %v", err)
+	}
+
+	err = srv.ExportChannel(ctx, serverID, channelID, f)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tmpPath)
+		log.Fatalf("error exporting channel: %v", err)
+	}
+
+	if err := os.Rename(tmpPath, *outputPath); err != nil {
+		log.Fatalf("error writing the archive: %v", err)
+	}
+
+	log.Printf("channel %s exported to %s", channelID, *outputPath)
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+
+	"github.com/confa-chat/node/pkg/chatimport"
+	"github.com/confa-chat/node/pkg/uuid"
+	"github.com/confa-chat/node/src/confa"
+	"github.com/confa-chat/node/src/config"
+	"github.com/confa-chat/node/src/store"
+	"github.com/confa-chat/node/src/store/attachment"
+)
+
+// runImport implements the import subcommand, which creates a server from a Slack or Discord export
+func runImport(args []string) {
+	flags := flag.NewFlagSet("import", flag.ExitOnError)
+	configFilePath := flags.String("config", "", "Path to YAML configuration file")
+	format := flags.String("format", "", "Format of the export: slack or discord")
+	inputPath := flags.String("path", "", "Path of the export, a zip archive, a directory or a JSON file")
+	serverName := flags.String("name", "", "Name of the created server, defaults to the name in the export")
+	owner := flags.String("owner", "", "ID of the user who owns the created server, the node owns it when empty")
+	flags.Parse(args)
+
+	var ownerID uuid.UUID
+	if *owner != "" {
+		var err error
+		ownerID, err = uuid.FromString(*owner)
+		if err != nil {
+			log.Fatalf("invalid owner ID: %v", err)
+		}
+	}
+
+	fsys, closer, err := chatimport.OpenArchive(*inputPath)
+	if err != nil {
+		log.Fatalf("error opening the export: %v", err)
+	}
+	defer closer.Close()
+
+	var src chatimport.Source
+	switch *format {
+	case "slack":
+		name := *serverName
+		if name == "" {
+			name = "Slack"
+		}
+		src, err = chatimport.NewSlack(fsys, name)
+	case "discord":
+		var d *chatimport.Discord
+		d, err = chatimport.NewDiscord(fsys)
+		if err == nil && *serverName != "" {
+			d.SetServerName(*serverName)
+		}
+		src = d
+	default:
+		log.Fatalf("unknown export format %q, expected slack or discord", *format)
+	}
+	if err != nil {
+		log.Fatalf("error reading the export: %v", err)
+	}
+
+	ctx := context.Background()
+
+	cfg, err := config.Load(*configFilePath)
+	if err != nil {
+		log.Fatalf("error loading config: %v", err)
+	}
+
+	db, dbpool, err := store.ConnectPostgres(ctx, cfg.DB)
+	if err != nil {
+		log.Fatalf("error connecting to the database: %v", err)
+	}
+
+	attachStorage, err := attachment.NewStorageFromConfig(&cfg.AttachmentConfig)
+	if err != nil {
+		log.Fatalf("error initializing attachment storage: %v", err)
+	}
+
+	srv := confa.NewService(db, dbpool, cfg, attachStorage)
+
+	result, err := srv.ImportArchive(ctx, ownerID, src)
+	if err != nil {
+		log.Fatalf("error importing the export: %v", err)
+	}
+
+	log.Printf("imported %d messages from %d channels by %d users with %d attachments into server %s",
+		result.Messages, result.Channels, result.Users, result.Attachments, result.ServerID)
+}
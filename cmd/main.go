@@ -33,6 +33,15 @@ func main() {
 	zerologLogger := zerolog.New(zerolog.ConsoleWriter{Out: os.Stderr})
 	slog.SetDefault(slog.New(slogzerolog.Option{Level: slog.LevelDebug, Logger: &zerologLogger}.NewZerologHandler()))
 
+	if len(os.Args) > 1 && os.Args[1] == "export" {
+		runExport(os.Args[2:])
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == "import" {
+		runImport(os.Args[2:])
+		return
+	}
+
 	slog.Info("Confa Node starting")
 
 	// Parse command line flags
@@ -60,15 +69,26 @@ func main() {
 
 	// Pass the entire config object and attachment storage to the service
 	srv := confa.NewService(db, dbpool, cfg, attachStorage)
+	go srv.RunScheduledMessages(ctx)
+	go srv.RunMessageReaper(ctx)
+
+	serverID, chanID, err := createDefaultServer(ctx, srv)
+	if err != nil {
+		panic(err)
+	}
+	srv.DefaultServerID = serverID
+
+	slog.Info("Server ID: %s, Channel ID: %s", serverID.String(), chanID.String())
 
 	// Use the first auth provider for the authenticator
 	// In a more robust implementation, this might be configurable
 	provider := cfg.AuthProviders[0]
 	authen, err := auth.NewAuthenticator(ctx, db,
 		auth.AuthenticatorConfig{
-			Issuer:       provider.OpenIDConnect.Issuer,
-			ClientID:     provider.OpenIDConnect.ClientID,
-			ClientSecret: provider.OpenIDConnect.ClientSecret,
+			Issuer:          provider.OpenIDConnect.Issuer,
+			ClientID:        provider.OpenIDConnect.ClientID,
+			ClientSecret:    provider.OpenIDConnect.ClientSecret,
+			DefaultServerID: serverID,
 		},
 		[]string{
 			"/grpc.reflection.v1alpha.ServerReflection",
@@ -91,13 +111,6 @@ func main() {
 
 	reflection.Register(grpcServer)
 
-	serverID, chanID, err := createDefaultServer(ctx, srv)
-	if err != nil {
-		panic(err)
-	}
-
-	slog.Info("Server ID: %s, Channel ID: %s", serverID.String(), chanID.String())
-
 	// Create an HTTP mux for handling attachments
 	httpMux := http.NewServeMux()
 	attachmentHandler := attachment.NewHTTPHandler(attachStorage)
@@ -143,7 +156,7 @@ func createDefaultServer(ctx context.Context, srv *confa.Service) (uuid.UUID, uu
 		}
 	}
 	if serverID == uuid.Nil {
-		serverID, err = srv.CreateServer(ctx, "confach")
+		serverID, err = srv.CreateServer(ctx, uuid.Nil, "confach")
 		if err != nil {
 			return uuid.Nil, uuid.Nil, fmt.Errorf("failed to create server: %w", err)
 		}
@@ -0,0 +1,107 @@
+// Package chatimport reads chat history exported from other chat platforms.
+//
+// Exports are read through fs.FS, so they can be imported from a zip archive or an unpacked directory.
+package chatimport
+
+import (
+	"archive/zip"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// User is an author of imported messages
+type User struct {
+	// ExternalID is the ID of the user on the source platform
+	ExternalID string
+	Name       string
+}
+
+// Channel is an imported channel
+type Channel struct {
+	// ExternalID is the ID of the channel on the source platform
+	ExternalID string
+	Name       string
+
+	// path is where the messages of the channel are stored in the export
+	path string
+}
+
+// Attachment is a file attached to a message which is included in the export
+type Attachment struct {
+	Name string
+	// Path is the location of the file in the export
+	Path string
+}
+
+// Message is an imported message, its content is converted to the markdown supported by the node
+type Message struct {
+	// ExternalID is the ID of the message on the source platform
+	ExternalID string
+	// ParentExternalID is set for replies in a thread
+	ParentExternalID string
+	Author           User
+	Content          string
+	Timestamp        time.Time
+	EditedAt         *time.Time
+	Attachments      []Attachment
+}
+
+// Source is an export of a chat platform
+type Source interface {
+	// Platform is the short name of the platform the export comes from, like "slack"
+	Platform() string
+	// ServerName is the name of the exported workspace or guild
+	ServerName() string
+	// Channels returns the exported channels
+	Channels() []Channel
+	// Messages calls fn for every message of the channel, oldest first.
+	// Thread replies come after the message they reply to.
+	Messages(ch Channel, fn func(Message) error) error
+	// Open opens a file attached to a message
+	Open(a Attachment) (io.ReadCloser, error)
+}
+
+// OpenArchive opens a zip archive or a directory containing an export.
+// A single JSON file is opened as a directory containing only that file.
+func OpenArchive(p string) (fs.FS, io.Closer, error) {
+	info, err := os.Stat(p)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch {
+	case info.IsDir():
+		return os.DirFS(p), io.NopCloser(nil), nil
+	case strings.EqualFold(filepath.Ext(p), ".zip"):
+		r, err := zip.OpenReader(p)
+		if err != nil {
+			return nil, nil, err
+		}
+		return r, r, nil
+	default:
+		return singleFileFS{FS: os.DirFS(filepath.Dir(p)), name: filepath.Base(p)}, io.NopCloser(nil), nil
+	}
+}
+
+// singleFileFS is a directory where only one JSON file is listed, other files can still be opened
+type singleFileFS struct {
+	fs.FS
+	name string
+}
+
+func (f singleFileFS) Glob(pattern string) ([]string, error) {
+	if ok, err := path.Match(pattern, f.name); !ok || err != nil {
+		return nil, err
+	}
+	return []string{f.name}, nil
+}
+
+func exists(fsys fs.FS, name string) bool {
+	_, err := fs.Stat(fsys, name)
+	return err == nil
+}
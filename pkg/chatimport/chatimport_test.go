@@ -0,0 +1,146 @@
+package chatimport
+
+import (
+	"io"
+	"testing"
+	"testing/fstest"
+	"time"
+)
+
+func collect(t *testing.T, src Source, ch Channel) []Message {
+	t.Helper()
+	var messages []Message
+	err := src.Messages(ch, func(m Message) error {
+		messages = append(messages, m)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Messages: %v", err)
+	}
+	return messages
+}
+
+func TestSlack(t *testing.T) {
+	fsys := fstest.MapFS{
+		"users.json": {Data: []byte(`[
+			{"id": "U1", "name": "alice", "profile": {"display_name": "Alice"}},
+			{"id": "U2", "name": "bob", "profile": {"display_name": ""}}
+		]`)},
+		"channels.json": {Data: []byte(`[{"id": "C1", "name": "general"}]`)},
+		"general/2024-01-02.json": {Data: []byte(`[
+			{"type": "message", "user": "U2", "text": "reply", "ts": "1704153600.000200", "thread_ts": "1704067200.000100"}
+		]`)},
+		"general/2024-01-01.json": {Data: []byte(`[
+			{"type": "message", "subtype": "channel_join", "user": "U2", "text": "<@U2> has joined", "ts": "1704067100.000000"},
+			{"type": "message", "user": "U1", "text": "hi <@U2>, *look* at <https://example.com|this> &amp; <#C1|general>",
+			 "ts": "1704067200.000100", "thread_ts": "1704067200.000100", "edited": {"ts": "1704067300.000000"},
+			 "files": [{"id": "F1", "name": "a.txt"}, {"id": "F2", "name": "missing.txt"}]}
+		]`)},
+		"__uploads/F1/a.txt": {Data: []byte("attached")},
+	}
+
+	src, err := NewSlack(fsys, "Workspace")
+	if err != nil {
+		t.Fatalf("NewSlack: %v", err)
+	}
+	if src.ServerName() != "Workspace" {
+		t.Errorf("ServerName = %q", src.ServerName())
+	}
+
+	channels := src.Channels()
+	if len(channels) != 1 || channels[0].Name != "general" {
+		t.Fatalf("Channels = %+v", channels)
+	}
+
+	messages := collect(t, src, channels[0])
+	if len(messages) != 2 {
+		t.Fatalf("got %d messages, want 2", len(messages))
+	}
+
+	first := messages[0]
+	if want := "hi @bob, **look** at [this](https://example.com) & #general"; first.Content != want {
+		t.Errorf("Content = %q, want %q", first.Content, want)
+	}
+	if first.Author.Name != "Alice" {
+		t.Errorf("Author = %+v", first.Author)
+	}
+	if !first.Timestamp.Equal(time.Unix(1704067200, 100_000)) {
+		t.Errorf("Timestamp = %v", first.Timestamp)
+	}
+	if first.EditedAt == nil || first.ParentExternalID != "" {
+		t.Errorf("EditedAt = %v, ParentExternalID = %q", first.EditedAt, first.ParentExternalID)
+	}
+	if len(first.Attachments) != 1 || first.Attachments[0].Path != "__uploads/F1/a.txt" {
+		t.Fatalf("Attachments = %+v", first.Attachments)
+	}
+
+	r, err := src.Open(first.Attachments[0])
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	data, _ := io.ReadAll(r)
+	r.Close()
+	if string(data) != "attached" {
+		t.Errorf("attachment = %q", data)
+	}
+
+	if messages[1].ParentExternalID != first.ExternalID {
+		t.Errorf("ParentExternalID = %q, want %q", messages[1].ParentExternalID, first.ExternalID)
+	}
+}
+
+func TestDiscord(t *testing.T) {
+	fsys := fstest.MapFS{
+		"general.json": {Data: []byte(`{
+			"guild": {"id": "G1", "name": "Guild"},
+			"channel": {"id": "C1", "type": "GuildTextChat", "name": "general"},
+			"dateRange": {"after": null, "before": null},
+			"messages": [
+				{"id": "M1", "type": "Default", "timestamp": "2024-01-01T10:00:00+02:00", "timestampEdited": null,
+				 "content": "hello <@2>", "author": {"id": "1", "name": "alice", "nickname": "Alice"},
+				 "mentions": [{"id": "2", "name": "bob", "nickname": "Bob"}],
+				 "attachments": [
+					{"id": "A1", "url": "general.json_Files/a.txt", "fileName": "a.txt"},
+					{"id": "A2", "url": "https://cdn.discordapp.com/b.txt", "fileName": "b.txt"}
+				 ]},
+				{"id": "M2", "type": "ChannelPinnedMessage", "timestamp": "2024-01-01T10:01:00+00:00",
+				 "content": "", "author": {"id": "1", "name": "alice"}}
+			],
+			"messageCount": 2
+		}`)},
+		"general.json_Files/a.txt": {Data: []byte("attached")},
+		"notes.json":               {Data: []byte(`{"unrelated": true}`)},
+	}
+
+	src, err := NewDiscord(fsys)
+	if err != nil {
+		t.Fatalf("NewDiscord: %v", err)
+	}
+	if src.ServerName() != "Guild" {
+		t.Errorf("ServerName = %q", src.ServerName())
+	}
+
+	channels := src.Channels()
+	if len(channels) != 1 || channels[0].ExternalID != "C1" {
+		t.Fatalf("Channels = %+v", channels)
+	}
+
+	messages := collect(t, src, channels[0])
+	if len(messages) != 1 {
+		t.Fatalf("got %d messages, want 1", len(messages))
+	}
+
+	m := messages[0]
+	if m.Content != "hello @Bob" {
+		t.Errorf("Content = %q", m.Content)
+	}
+	if m.Author != (User{ExternalID: "1", Name: "Alice"}) {
+		t.Errorf("Author = %+v", m.Author)
+	}
+	if !m.Timestamp.Equal(time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC)) {
+		t.Errorf("Timestamp = %v", m.Timestamp)
+	}
+	if len(m.Attachments) != 1 || m.Attachments[0] != (Attachment{Name: "a.txt", Path: "general.json_Files/a.txt"}) {
+		t.Errorf("Attachments = %+v", m.Attachments)
+	}
+}
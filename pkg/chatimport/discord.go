@@ -0,0 +1,241 @@
+package chatimport
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/url"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Discord reads JSON exports of DiscordChatExporter, one file per channel.
+// Attachments are imported when the export was made with media, so their URLs are paths relative to the JSON file.
+type Discord struct {
+	fsys     fs.FS
+	name     string
+	channels []Channel
+}
+
+type discordHeader struct {
+	Guild struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"guild"`
+	Channel struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"channel"`
+}
+
+type discordUser struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Nickname string `json:"nickname"`
+}
+
+type discordMessage struct {
+	ID              string        `json:"id"`
+	Type            string        `json:"type"`
+	Timestamp       time.Time     `json:"timestamp"`
+	TimestampEdited *time.Time    `json:"timestampEdited"`
+	Content         string        `json:"content"`
+	Author          discordUser   `json:"author"`
+	Mentions        []discordUser `json:"mentions"`
+	Attachments     []struct {
+		URL      string `json:"url"`
+		FileName string `json:"fileName"`
+	} `json:"attachments"`
+}
+
+// discordTypes are the kinds of messages written by people, other types are channel events
+var discordTypes = []string{"Default", "Reply"}
+
+var _ Source = (*Discord)(nil)
+
+// NewDiscord opens a directory or an archive of DiscordChatExporter JSON files
+func NewDiscord(fsys fs.FS) (*Discord, error) {
+	d := &Discord{fsys: fsys}
+
+	var files []string
+	for _, pattern := range []string{"*.json", "*/*.json"} {
+		matches, err := fs.Glob(fsys, pattern)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, matches...)
+	}
+	slices.Sort(files)
+
+	for _, file := range files {
+		header, err := d.readHeader(file)
+		if err != nil {
+			return nil, err
+		}
+		if header.Channel.ID == "" {
+			continue
+		}
+		if d.name == "" {
+			d.name = header.Guild.Name
+		}
+		d.channels = append(d.channels, Channel{ExternalID: header.Channel.ID, Name: header.Channel.Name, path: file})
+	}
+
+	if len(d.channels) == 0 {
+		return nil, fmt.Errorf("no Discord channel exports found")
+	}
+
+	return d, nil
+}
+
+// SetServerName replaces the guild name of the export
+func (d *Discord) SetServerName(name string) {
+	d.name = name
+}
+
+func (d *Discord) Platform() string {
+	return "discord"
+}
+
+func (d *Discord) ServerName() string {
+	return d.name
+}
+
+func (d *Discord) Channels() []Channel {
+	return d.channels
+}
+
+func (d *Discord) Open(a Attachment) (io.ReadCloser, error) {
+	return d.fsys.Open(a.Path)
+}
+
+// readHeader reads the guild and the channel, which come before the messages
+func (d *Discord) readHeader(file string) (discordHeader, error) {
+	var header discordHeader
+	err := d.decodeMessages(file, &header, nil)
+	return header, err
+}
+
+func (d *Discord) Messages(ch Channel, fn func(Message) error) error {
+	return d.decodeMessages(ch.path, nil, func(m discordMessage) error {
+		if !slices.Contains(discordTypes, m.Type) {
+			return nil
+		}
+		return fn(d.convert(ch, m))
+	})
+}
+
+// decodeMessages streams the export file, so channels with a long history don't have to fit in memory.
+// The header is filled with the fields read before the messages, messages are skipped if fn is nil.
+func (d *Discord) decodeMessages(file string, header *discordHeader, fn func(discordMessage) error) error {
+	f, err := d.fsys.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	dec := json.NewDecoder(f)
+	if err := expectDelim(dec, '{'); err != nil {
+		return fmt.Errorf("%s: %w", file, err)
+	}
+
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return fmt.Errorf("%s: %w", file, err)
+		}
+
+		switch key, _ := tok.(string); {
+		case key == "guild" && header != nil:
+			err = dec.Decode(&header.Guild)
+		case key == "channel" && header != nil:
+			err = dec.Decode(&header.Channel)
+		case key == "messages" && fn == nil:
+			return nil
+		case key == "messages":
+			err = decodeArray(dec, fn)
+		default:
+			var skip json.RawMessage
+			err = dec.Decode(&skip)
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %w", file, err)
+		}
+	}
+
+	return nil
+}
+
+func decodeArray(dec *json.Decoder, fn func(discordMessage) error) error {
+	if err := expectDelim(dec, '['); err != nil {
+		return err
+	}
+	for dec.More() {
+		var m discordMessage
+		if err := dec.Decode(&m); err != nil {
+			return err
+		}
+		if err := fn(m); err != nil {
+			return err
+		}
+	}
+	return expectDelim(dec, ']')
+}
+
+func expectDelim(dec *json.Decoder, delim json.Delim) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok != delim {
+		return fmt.Errorf("expected %v, got %v", delim, tok)
+	}
+	return nil
+}
+
+// discordMentionRe matches user mentions which were not resolved to names by the exporter
+var discordMentionRe = regexp.MustCompile(`<@!?(\d+)>`)
+
+func (d *Discord) convert(ch Channel, m discordMessage) Message {
+	msg := Message{
+		ExternalID: m.ID,
+		Author:     discordAuthor(m.Author),
+		Timestamp:  m.Timestamp.UTC(),
+		EditedAt:   m.TimestampEdited,
+	}
+
+	msg.Content = discordMentionRe.ReplaceAllStringFunc(m.Content, func(ref string) string {
+		id := discordMentionRe.FindStringSubmatch(ref)[1]
+		for _, u := range m.Mentions {
+			if u.ID == id {
+				return "@" + discordAuthor(u).Name
+			}
+		}
+		return ref
+	})
+
+	dir := path.Dir(ch.path)
+	for _, a := range m.Attachments {
+		if strings.Contains(a.URL, "://") {
+			// Media was not included in the export
+			continue
+		}
+		p := path.Join(dir, a.URL)
+		if unescaped, err := url.PathUnescape(a.URL); err == nil && !exists(d.fsys, p) {
+			p = path.Join(dir, unescaped)
+		}
+		if exists(d.fsys, p) {
+			msg.Attachments = append(msg.Attachments, Attachment{Name: firstNonEmpty(a.FileName, path.Base(p)), Path: p})
+		}
+	}
+
+	return msg
+}
+
+func discordAuthor(u discordUser) User {
+	return User{ExternalID: u.ID, Name: firstNonEmpty(u.Nickname, u.Name, u.ID)}
+}
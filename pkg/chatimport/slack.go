@@ -0,0 +1,273 @@
+package chatimport
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"io/fs"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Slack reads a Slack workspace export: users.json, channels.json and groups.json at the root,
+// and a directory per channel with a JSON file of messages per day.
+// Files are only imported when the export includes them under __uploads/<file id>/<name>.
+type Slack struct {
+	fsys     fs.FS
+	name     string
+	users    map[string]User
+	channels []Channel
+}
+
+type slackUser struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Profile struct {
+		DisplayName string `json:"display_name"`
+	} `json:"profile"`
+}
+
+type slackChannel struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type slackMessage struct {
+	Type     string `json:"type"`
+	Subtype  string `json:"subtype"`
+	User     string `json:"user"`
+	Username string `json:"username"`
+	BotID    string `json:"bot_id"`
+	Text     string `json:"text"`
+	TS       string `json:"ts"`
+	ThreadTS string `json:"thread_ts"`
+	Edited   *struct {
+		TS string `json:"ts"`
+	} `json:"edited"`
+	Files []struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"files"`
+}
+
+var _ Source = (*Slack)(nil)
+
+// slackSubtypes are the kinds of messages written by people, other subtypes are channel events
+var slackSubtypes = []string{"", "bot_message", "file_share", "me_message", "thread_broadcast"}
+
+// NewSlack opens a Slack export, name is used as the server name
+func NewSlack(fsys fs.FS, name string) (*Slack, error) {
+	s := &Slack{fsys: fsys, name: name, users: map[string]User{}}
+
+	var users []slackUser
+	if err := readJSON(fsys, "users.json", &users); err != nil {
+		return nil, err
+	}
+	for _, u := range users {
+		s.users[u.ID] = User{ExternalID: u.ID, Name: firstNonEmpty(u.Profile.DisplayName, u.Name, u.ID)}
+	}
+
+	for _, file := range []string{"channels.json", "groups.json"} {
+		var channels []slackChannel
+		err := readJSON(fsys, file, &channels)
+		if errors.Is(err, fs.ErrNotExist) && file != "channels.json" {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, ch := range channels {
+			s.channels = append(s.channels, Channel{ExternalID: ch.ID, Name: ch.Name, path: ch.Name})
+		}
+	}
+
+	return s, nil
+}
+
+func (s *Slack) Platform() string {
+	return "slack"
+}
+
+func (s *Slack) ServerName() string {
+	return s.name
+}
+
+func (s *Slack) Channels() []Channel {
+	return s.channels
+}
+
+func (s *Slack) Messages(ch Channel, fn func(Message) error) error {
+	// Day files are named YYYY-MM-DD.json, so the name order is the chronological order
+	days, err := fs.Glob(s.fsys, path.Join(ch.path, "*.json"))
+	if err != nil {
+		return err
+	}
+	slices.Sort(days)
+
+	for _, day := range days {
+		var messages []slackMessage
+		if err := readJSON(s.fsys, day, &messages); err != nil {
+			return err
+		}
+		slices.SortStableFunc(messages, func(a, b slackMessage) int {
+			return compareSlackTS(a.TS, b.TS)
+		})
+
+		for _, m := range messages {
+			if m.Type != "message" || !slices.Contains(slackSubtypes, m.Subtype) {
+				continue
+			}
+
+			msg, err := s.convert(m)
+			if err != nil {
+				return fmt.Errorf("%s: %w", day, err)
+			}
+			if err := fn(msg); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *Slack) Open(a Attachment) (io.ReadCloser, error) {
+	return s.fsys.Open(a.Path)
+}
+
+func (s *Slack) convert(m slackMessage) (Message, error) {
+	ts, err := parseSlackTS(m.TS)
+	if err != nil {
+		return Message{}, err
+	}
+
+	msg := Message{
+		ExternalID: m.TS,
+		Author:     s.user(m),
+		Content:    s.convertText(m.Text),
+		Timestamp:  ts,
+	}
+	if m.ThreadTS != "" && m.ThreadTS != m.TS {
+		msg.ParentExternalID = m.ThreadTS
+	}
+	if m.Edited != nil {
+		if editedAt, err := parseSlackTS(m.Edited.TS); err == nil {
+			msg.EditedAt = &editedAt
+		}
+	}
+
+	for _, f := range m.Files {
+		p := path.Join("__uploads", f.ID, f.Name)
+		if f.ID != "" && f.Name != "" && exists(s.fsys, p) {
+			msg.Attachments = append(msg.Attachments, Attachment{Name: f.Name, Path: p})
+		}
+	}
+
+	return msg, nil
+}
+
+func (s *Slack) user(m slackMessage) User {
+	if u, ok := s.users[m.User]; ok {
+		return u
+	}
+	if m.BotID != "" {
+		return User{ExternalID: m.BotID, Name: firstNonEmpty(m.Username, m.BotID)}
+	}
+	return User{ExternalID: m.User, Name: firstNonEmpty(m.Username, m.User)}
+}
+
+var (
+	// slackRefRe matches <@U123>, <#C123|name>, <!here> and <https://example.com|label>
+	slackRefRe = regexp.MustCompile(`<([^<>]+)>`)
+	// slackBoldRe matches *bold*, which is **bold** in markdown
+	slackBoldRe = regexp.MustCompile(`(^|[^\p{L}\p{N}*])\*([^*\n]+)\*($|[^\p{L}\p{N}*])`)
+	// slackStrikeRe matches ~strike~, strikethrough is not supported so only the text is kept
+	slackStrikeRe = regexp.MustCompile(`(^|[^\p{L}\p{N}~])~([^~\n]+)~($|[^\p{L}\p{N}~])`)
+)
+
+// convertText converts Slack mrkdwn to markdown
+func (s *Slack) convertText(text string) string {
+	text = slackRefRe.ReplaceAllStringFunc(text, func(ref string) string {
+		ref = ref[1 : len(ref)-1]
+		target, label, _ := strings.Cut(ref, "|")
+
+		switch {
+		case strings.HasPrefix(target, "@"):
+			if u, ok := s.users[target[1:]]; ok {
+				return "@" + u.Name
+			}
+			return "@" + firstNonEmpty(label, target[1:])
+		case strings.HasPrefix(target, "#"):
+			return "#" + firstNonEmpty(label, target[1:])
+		case strings.HasPrefix(target, "!"):
+			// <!here>, <!channel>, <!everyone> and <!subteam^ID|@team>
+			name, _, _ := strings.Cut(target[1:], "^")
+			return "@" + strings.TrimPrefix(firstNonEmpty(label, name), "@")
+		case label != "":
+			return "[" + label + "](" + target + ")"
+		default:
+			return target
+		}
+	})
+
+	text = slackBoldRe.ReplaceAllString(text, "$1**$2**$3")
+	text = slackStrikeRe.ReplaceAllString(text, "$1$2$3")
+
+	return html.UnescapeString(text)
+}
+
+// parseSlackTS parses a Slack message timestamp, seconds and microseconds separated by a dot
+func parseSlackTS(ts string) (time.Time, error) {
+	secStr, usecStr, _ := strings.Cut(ts, ".")
+	sec, err := strconv.ParseInt(secStr, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid timestamp %q: %w", ts, err)
+	}
+
+	var usec int64
+	if usecStr != "" {
+		usec, err = strconv.ParseInt((usecStr + "000000")[:6], 10, 64)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid timestamp %q: %w", ts, err)
+		}
+	}
+
+	return time.Unix(sec, usec*1000).UTC(), nil
+}
+
+func compareSlackTS(a, b string) int {
+	ta, errA := parseSlackTS(a)
+	tb, errB := parseSlackTS(b)
+	if errA != nil || errB != nil {
+		return strings.Compare(a, b)
+	}
+	return ta.Compare(tb)
+}
+
+func readJSON(fsys fs.FS, name string, v any) error {
+	f, err := fsys.Open(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err := json.NewDecoder(f).Decode(v); err != nil && err != io.EOF {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	return nil
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
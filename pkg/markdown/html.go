@@ -0,0 +1,48 @@
+package markdown
+
+import (
+	"html"
+	"io"
+	"strings"
+)
+
+// RenderHTML writes the tree as HTML. All text is escaped, so the output is safe to embed in a page.
+func RenderHTML(w io.Writer, nodes []Node) error {
+	var b strings.Builder
+	renderHTML(&b, nodes)
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func renderHTML(b *strings.Builder, nodes []Node) {
+	for _, node := range nodes {
+		switch node.Type {
+		case NodeText:
+			b.WriteString(strings.ReplaceAll(html.EscapeString(node.Text), "\n", "<br>"))
+		case NodeBold:
+			wrapHTML(b, "<strong>", "</strong>", node.Children)
+		case NodeItalic:
+			wrapHTML(b, "<em>", "</em>", node.Children)
+		case NodeSpoiler:
+			wrapHTML(b, `<span class="spoiler">`, "</span>", node.Children)
+		case NodeCode:
+			b.WriteString("<code>" + html.EscapeString(node.Text) + "</code>")
+		case NodeCodeBlock:
+			b.WriteString("<pre><code")
+			if node.Language != "" {
+				b.WriteString(` class="language-` + html.EscapeString(node.Language) + `"`)
+			}
+			b.WriteString(">" + html.EscapeString(node.Text) + "</code></pre>")
+		case NodeLink:
+			wrapHTML(b, `<a href="`+html.EscapeString(node.URL)+`" rel="nofollow noopener noreferrer">`, "</a>", node.Children)
+		case NodeMention:
+			b.WriteString(`<span class="mention">@` + html.EscapeString(node.Text) + "</span>")
+		}
+	}
+}
+
+func wrapHTML(b *strings.Builder, open, close string, children []Node) {
+	b.WriteString(open)
+	renderHTML(b, children)
+	b.WriteString(close)
+}
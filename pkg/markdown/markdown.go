@@ -0,0 +1,316 @@
+// Package markdown parses the subset of markdown supported in messages into a sanitized tree.
+//
+// Only bold, italics, inline code, code blocks, links, mentions and spoilers are recognized,
+// everything else, including raw HTML, is kept as plain text and must be rendered as such.
+package markdown
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// NodeType is the kind of a node in the message tree
+type NodeType string
+
+const (
+	NodeText      NodeType = "text"
+	NodeBold      NodeType = "bold"
+	NodeItalic    NodeType = "italic"
+	NodeCode      NodeType = "code"
+	NodeCodeBlock NodeType = "code_block"
+	NodeLink      NodeType = "link"
+	NodeMention   NodeType = "mention"
+	NodeSpoiler   NodeType = "spoiler"
+)
+
+// Node is an element of the message tree
+type Node struct {
+	Type NodeType `json:"type"`
+	// Text is the content of text and code nodes, or the mentioned name without the @
+	Text     string `json:"text,omitempty"`
+	URL      string `json:"url,omitempty"`
+	Language string `json:"language,omitempty"`
+	Children []Node `json:"children,omitempty"`
+}
+
+// maxDepth limits nesting of formatting, deeper markup is kept as text
+const maxDepth = 8
+
+// mentionRe uses the same name characters as mentions resolved by the node
+var mentionRe = regexp.MustCompile(`^@([\p{L}\p{N}_.\-]+)`)
+
+// Parse returns the tree of the message content.
+// Unterminated or unsafe constructs such as links to other schemes than http, https and mailto are kept as text.
+func Parse(content string) []Node {
+	return parseInline(sanitize(content), 0)
+}
+
+// sanitize drops invalid UTF-8, control characters other than newlines and tabs,
+// and bidirectional overrides which can be used to disguise links
+func sanitize(s string) string {
+	s = strings.ToValidUTF8(s, "")
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r == '\n' || r == '\t':
+			return r
+		case unicode.IsControl(r):
+			return -1
+		case r >= '\u202a' && r <= '\u202e', r >= '\u2066' && r <= '\u2069':
+			return -1
+		default:
+			return r
+		}
+	}, s)
+}
+
+func parseInline(s string, depth int) []Node {
+	var nodes []Node
+	var text strings.Builder
+	push := func(node Node) {
+		if text.Len() > 0 {
+			nodes = append(nodes, Node{Type: NodeText, Text: text.String()})
+			text.Reset()
+		}
+		nodes = append(nodes, node)
+	}
+
+	for i := 0; i < len(s); {
+		rest := s[i:]
+		atWordStart := i == 0 || !isWordRune(lastRune(s[:i]))
+
+		if rest[0] == '\\' && len(rest) > 1 && isASCIIPunct(rest[1]) {
+			text.WriteByte(rest[1])
+			i += 2
+			continue
+		}
+
+		if rest[0] == '`' {
+			node, n := parseCode(rest, depth)
+			if n > 0 {
+				push(node)
+			} else {
+				// Unterminated backticks are kept as they are
+				n = runLength(rest, '`')
+				text.WriteString(rest[:n])
+			}
+			i += n
+			continue
+		}
+
+		if depth < maxDepth {
+			if node, n := parseFormatting(rest, depth, atWordStart); n > 0 {
+				push(node)
+				i += n
+				continue
+			}
+		}
+
+		if rest[0] == '[' {
+			if node, n := parseLink(rest, depth); n > 0 {
+				push(node)
+				i += n
+				continue
+			}
+		}
+
+		if atWordStart && (strings.HasPrefix(rest, "http://") || strings.HasPrefix(rest, "https://")) {
+			if node, n := parseAutolink(rest); n > 0 {
+				push(node)
+				i += n
+				continue
+			}
+		}
+
+		if atWordStart && rest[0] == '@' {
+			if m := mentionRe.FindStringSubmatch(rest); m != nil {
+				// Trailing punctuation belongs to the sentence, not to the name
+				if name := strings.TrimRight(m[1], ".-"); name != "" {
+					push(Node{Type: NodeMention, Text: name})
+					i += len(name) + 1
+					continue
+				}
+			}
+		}
+
+		_, size := utf8.DecodeRuneInString(rest)
+		text.WriteString(rest[:size])
+		i += size
+	}
+
+	if text.Len() > 0 {
+		nodes = append(nodes, Node{Type: NodeText, Text: text.String()})
+	}
+	return nodes
+}
+
+// parseCode parses inline code or, at the top level, a code block fenced by three or more backticks.
+// It returns the length of the consumed input, zero if the code is not terminated.
+func parseCode(s string, depth int) (Node, int) {
+	fence := s[:runLength(s, '`')]
+	end := strings.Index(s[len(fence):], fence)
+	if end <= 0 {
+		return Node{}, 0
+	}
+	body := s[len(fence) : len(fence)+end]
+	n := len(fence)*2 + end
+
+	if len(fence) < 3 || depth > 0 {
+		return Node{Type: NodeCode, Text: body}, n
+	}
+
+	var lang string
+	if nl := strings.IndexByte(body, '\n'); nl >= 0 {
+		if first := body[:nl]; !strings.ContainsFunc(first, unicode.IsSpace) {
+			lang = first
+			body = body[nl+1:]
+		}
+	}
+	body = strings.TrimSuffix(body, "\n")
+
+	return Node{Type: NodeCodeBlock, Text: body, Language: lang}, n
+}
+
+// parseFormatting parses bold, spoiler and italic spans
+func parseFormatting(s string, depth int, atWordStart bool) (Node, int) {
+	for _, f := range []struct {
+		delim string
+		typ   NodeType
+	}{
+		{"**", NodeBold},
+		{"||", NodeSpoiler},
+		{"*", NodeItalic},
+		{"_", NodeItalic},
+	} {
+		if !strings.HasPrefix(s, f.delim) {
+			continue
+		}
+		// snake_case identifiers are not italics
+		if f.delim == "_" && !atWordStart {
+			return Node{}, 0
+		}
+
+		inner, n := delimited(s, f.delim)
+		if n == 0 {
+			continue
+		}
+		if f.delim == "_" && n < len(s) && isWordRune(firstRune(s[n:])) {
+			continue
+		}
+
+		return Node{Type: f.typ, Children: parseInline(inner, depth+1)}, n
+	}
+
+	return Node{}, 0
+}
+
+// delimited returns the text between the delimiter at the start of s and its closing counterpart.
+// The text must not be empty nor start or end with a space, so "2 * 3 * 4" stays as it is.
+func delimited(s, delim string) (string, int) {
+	end := strings.Index(s[len(delim):], delim)
+	if end <= 0 {
+		return "", 0
+	}
+	inner := s[len(delim) : len(delim)+end]
+	if unicode.IsSpace(firstRune(inner)) || unicode.IsSpace(lastRune(inner)) {
+		return "", 0
+	}
+	return inner, len(delim)*2 + end
+}
+
+// parseLink parses [label](url), links with unsafe URLs are not parsed
+func parseLink(s string, depth int) (Node, int) {
+	labelEnd := strings.Index(s, "](")
+	if labelEnd <= 1 {
+		return Node{}, 0
+	}
+	urlEnd := strings.IndexByte(s[labelEnd+2:], ')')
+	if urlEnd <= 0 {
+		return Node{}, 0
+	}
+
+	label := s[1:labelEnd]
+	target, ok := safeURL(s[labelEnd+2 : labelEnd+2+urlEnd])
+	if !ok || strings.Contains(label, "\n") {
+		return Node{}, 0
+	}
+
+	children := []Node{{Type: NodeText, Text: label}}
+	if depth < maxDepth {
+		children = parseInline(label, depth+1)
+	}
+
+	return Node{Type: NodeLink, URL: target, Children: children}, labelEnd + 3 + urlEnd
+}
+
+// parseAutolink parses a bare http or https URL
+func parseAutolink(s string) (Node, int) {
+	end := strings.IndexFunc(s, unicode.IsSpace)
+	if end < 0 {
+		end = len(s)
+	}
+	// Trailing punctuation belongs to the sentence, not to the URL
+	raw := strings.TrimRight(s[:end], ".,;:!?)'\"")
+
+	target, ok := safeURL(raw)
+	if !ok {
+		return Node{}, 0
+	}
+
+	return Node{Type: NodeLink, URL: target, Children: []Node{{Type: NodeText, Text: raw}}}, len(raw)
+}
+
+// safeURL returns the normalized URL if it is allowed to be a link target
+func safeURL(raw string) (string, bool) {
+	if strings.ContainsFunc(raw, unicode.IsSpace) {
+		return "", false
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		return "", false
+	}
+
+	switch strings.ToLower(u.Scheme) {
+	case "http", "https":
+		if u.Host == "" {
+			return "", false
+		}
+	case "mailto":
+		if u.Opaque == "" {
+			return "", false
+		}
+	default:
+		return "", false
+	}
+
+	return u.String(), true
+}
+
+func runLength(s string, b byte) int {
+	n := 0
+	for n < len(s) && s[n] == b {
+		n++
+	}
+	return n
+}
+
+func isASCIIPunct(b byte) bool {
+	return strings.IndexByte("!\"#$%&'()*+,-./:;<=>?@[\\]^_`{|}~", b) >= 0
+}
+
+func isWordRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+func firstRune(s string) rune {
+	r, _ := utf8.DecodeRuneInString(s)
+	return r
+}
+
+func lastRune(s string) rune {
+	r, _ := utf8.DecodeLastRuneInString(s)
+	return r
+}
@@ -0,0 +1,65 @@
+package markdown
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func text(s string) Node {
+	return Node{Type: NodeText, Text: s}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []Node
+	}{
+		{"plain", "hello world", []Node{text("hello world")}},
+		{"bold", "a **b** c", []Node{text("a "), {Type: NodeBold, Children: []Node{text("b")}}, text(" c")}},
+		{"nested", "**bold _it_**", []Node{{Type: NodeBold, Children: []Node{
+			text("bold "), {Type: NodeItalic, Children: []Node{text("it")}},
+		}}}},
+		{"snake case", "snake_case_name", []Node{text("snake_case_name")}},
+		{"spaced stars", "2 * 3 * 4", []Node{text("2 * 3 * 4")}},
+		{"spoiler", "||secret||", []Node{{Type: NodeSpoiler, Children: []Node{text("secret")}}}},
+		{"inline code", "run `**x**`", []Node{text("run "), {Type: NodeCode, Text: "**x**"}}},
+		{"code block", "```go\nfmt.Println()\n```", []Node{{Type: NodeCodeBlock, Text: "fmt.Println()", Language: "go"}}},
+		{"unterminated code", "```go", []Node{text("```go")}},
+		{"link", "[docs](https://example.com/a)", []Node{{Type: NodeLink, URL: "https://example.com/a", Children: []Node{text("docs")}}}},
+		{"unsafe link", "[x](javascript:alert(1))", []Node{text("[x](javascript:alert(1))")}},
+		{"autolink", "see https://example.com.", []Node{
+			text("see "), {Type: NodeLink, URL: "https://example.com", Children: []Node{text("https://example.com")}}, text("."),
+		}},
+		{"mention", "hi @bob.", []Node{text("hi "), {Type: NodeMention, Text: "bob"}, text(".")}},
+		{"email", "mail a@b.c", []Node{text("mail a@b.c")}},
+		{"escape", `\*not\*`, []Node{text("*not*")}},
+		{"html", "<script>x</script>", []Node{text("<script>x</script>")}},
+		{"control characters", "a\x00b\u202ec", []Node{text("abc")}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Parse(tt.content)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parse(%q) = %+v, want %+v", tt.content, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderHTML(t *testing.T) {
+	var b strings.Builder
+	content := "**hi** <b>@bob</b> [x](https://example.com/?a=1&b=2)\n```\n<script>\n```"
+	if err := RenderHTML(&b, Parse(content)); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `<strong>hi</strong> &lt;b&gt;<span class="mention">@bob</span>&lt;/b&gt; ` +
+		`<a href="https://example.com/?a=1&amp;b=2" rel="nofollow noopener noreferrer">x</a><br>` +
+		`<pre><code>&lt;script&gt;</code></pre>`
+	if got := b.String(); got != want {
+		t.Errorf("RenderHTML() = %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,67 @@
+package unfurl
+
+import (
+	"sync"
+	"time"
+)
+
+type cacheEntry struct {
+	preview Preview
+	err     error
+	expires time.Time
+}
+
+// cache keeps fetch results for a limited time, it drops the entry closest to expiration when full
+type cache struct {
+	mu      sync.Mutex
+	entries map[string]cacheEntry
+	size    int
+	ttl     time.Duration
+}
+
+func newCache(size int, ttl time.Duration) *cache {
+	return &cache{
+		entries: make(map[string]cacheEntry, size),
+		size:    size,
+		ttl:     ttl,
+	}
+}
+
+func (c *cache) get(key string) (Preview, error, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[key]
+	if !ok || time.Now().After(e.expires) {
+		return Preview{}, nil, false
+	}
+	return e.preview, e.err, true
+}
+
+func (c *cache) set(key string, p Preview, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.entries[key]; !ok && len(c.entries) >= c.size {
+		c.evict()
+	}
+	c.entries[key] = cacheEntry{preview: p, err: err, expires: time.Now().Add(c.ttl)}
+}
+
+func (c *cache) evict() {
+	now := time.Now()
+	var oldest string
+	var oldestExpires time.Time
+	for key, e := range c.entries {
+		if now.After(e.expires) {
+			delete(c.entries, key)
+			continue
+		}
+		if oldest == "" || e.expires.Before(oldestExpires) {
+			oldest, oldestExpires = key, e.expires
+		}
+	}
+	if len(c.entries) >= c.size {
+		delete(c.entries, oldest)
+	}
+}
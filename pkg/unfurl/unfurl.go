@@ -0,0 +1,371 @@
+// Package unfurl fetches OpenGraph and oEmbed metadata of links to show previews of them.
+//
+// Requests are only sent to public addresses: the address is checked after the name is resolved,
+// so redirects and DNS rebinding can't be used to reach the internal network.
+package unfurl
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+
+	"golang.org/x/net/html"
+)
+
+var (
+	// ErrForbiddenAddress is returned when the link points to a private, loopback or otherwise non-public address
+	ErrForbiddenAddress = errors.New("forbidden address")
+	// ErrTooLarge is returned when the fetched resource is larger than the size cap
+	ErrTooLarge = errors.New("response too large")
+	// ErrUnsupported is returned when the link doesn't point to an HTML page or an image
+	ErrUnsupported = errors.New("unsupported content")
+)
+
+// Preview is the metadata of a linked page
+type Preview struct {
+	URL         string
+	Title       string
+	Description string
+	SiteName    string
+	// ImageURL is the absolute URL of the thumbnail, empty if the page has none
+	ImageURL string
+}
+
+// Image is a downloaded thumbnail
+type Image struct {
+	Data        []byte
+	ContentType string
+}
+
+// Options configures a Fetcher, zero values are replaced with defaults
+type Options struct {
+	Timeout     time.Duration
+	MaxBodySize int64
+	CacheTTL    time.Duration
+	CacheSize   int
+	UserAgent   string
+	// AllowPrivate disables the address check, it must only be used in tests
+	AllowPrivate bool
+}
+
+// Fetcher fetches link previews
+type Fetcher struct {
+	client *http.Client
+	opts   Options
+	cache  *cache
+}
+
+// NewFetcher creates a new Fetcher
+func NewFetcher(opts Options) *Fetcher {
+	if opts.Timeout <= 0 {
+		opts.Timeout = 5 * time.Second
+	}
+	if opts.MaxBodySize <= 0 {
+		opts.MaxBodySize = 2 << 20
+	}
+	if opts.CacheTTL <= 0 {
+		opts.CacheTTL = time.Hour
+	}
+	if opts.CacheSize <= 0 {
+		opts.CacheSize = 1000
+	}
+	if opts.UserAgent == "" {
+		opts.UserAgent = "ConfaBot/1.0 (link preview)"
+	}
+
+	dialer := &net.Dialer{Timeout: opts.Timeout}
+	if !opts.AllowPrivate {
+		dialer.Control = checkAddress
+	}
+
+	transport := &http.Transport{
+		// Proxies from the environment would bypass the address check
+		Proxy:                 nil,
+		DialContext:           dialer.DialContext,
+		TLSHandshakeTimeout:   opts.Timeout,
+		ResponseHeaderTimeout: opts.Timeout,
+		MaxIdleConns:          10,
+		IdleConnTimeout:       time.Minute,
+	}
+
+	return &Fetcher{
+		client: &http.Client{
+			Transport: transport,
+			Timeout:   opts.Timeout,
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				if len(via) >= 5 {
+					return errors.New("too many redirects")
+				}
+				if req.URL.Scheme != "http" && req.URL.Scheme != "https" {
+					return ErrUnsupported
+				}
+				return nil
+			},
+		},
+		opts:  opts,
+		cache: newCache(opts.CacheSize, opts.CacheTTL),
+	}
+}
+
+// checkAddress is called after the name is resolved and before connecting
+func checkAddress(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || !isPublicIP(ip) {
+		return fmt.Errorf("%w: %s", ErrForbiddenAddress, host)
+	}
+	return nil
+}
+
+var nonPublicNets = mustParseCIDRs(
+	"0.0.0.0/8",
+	"100.64.0.0/10",
+	"192.0.0.0/24",
+	"192.88.99.0/24",
+	"198.18.0.0/15",
+	"240.0.0.0/4",
+	"64:ff9b::/96",
+	"2002::/16",
+)
+
+func isPublicIP(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() || ip.IsMulticast() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() {
+		return false
+	}
+	for _, n := range nonPublicNets {
+		if n.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+func mustParseCIDRs(cidrs ...string) []*net.IPNet {
+	nets := make([]*net.IPNet, len(cidrs))
+	for i, cidr := range cidrs {
+		_, n, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		nets[i] = n
+	}
+	return nets
+}
+
+// Fetch returns the preview of the page, results are cached for the configured TTL
+func (f *Fetcher) Fetch(ctx context.Context, rawURL string) (Preview, error) {
+	if p, err, ok := f.cache.get(rawURL); ok {
+		return p, err
+	}
+
+	p, err := f.fetch(ctx, rawURL)
+	// Failures are cached too, so a broken link is not fetched for every message
+	if !errors.Is(err, context.Canceled) {
+		f.cache.set(rawURL, p, err)
+	}
+	return p, err
+}
+
+func (f *Fetcher) fetch(ctx context.Context, rawURL string) (Preview, error) {
+	resp, err := f.get(ctx, rawURL, "text/html,application/xhtml+xml")
+	if err != nil {
+		return Preview{}, err
+	}
+	defer resp.Body.Close()
+
+	if mediaType(resp) != "text/html" && mediaType(resp) != "application/xhtml+xml" {
+		return Preview{}, ErrUnsupported
+	}
+
+	// Metadata is in the head, so a page over the cap is parsed as far as it was read
+	meta, err := parseHTML(io.LimitReader(resp.Body, f.opts.MaxBodySize))
+	if err != nil {
+		return Preview{}, err
+	}
+
+	base := resp.Request.URL
+	p := Preview{
+		URL:         rawURL,
+		Title:       firstNonEmpty(meta["og:title"], meta["twitter:title"], meta["title"]),
+		Description: firstNonEmpty(meta["og:description"], meta["twitter:description"], meta["description"]),
+		SiteName:    meta["og:site_name"],
+		ImageURL:    resolve(base, firstNonEmpty(meta["og:image"], meta["twitter:image"])),
+	}
+
+	if oembedURL := resolve(base, meta["oembed"]); oembedURL != "" && (p.Title == "" || p.ImageURL == "" || p.SiteName == "") {
+		if o, err := f.fetchOEmbed(ctx, oembedURL); err == nil {
+			p.Title = firstNonEmpty(p.Title, o.Title)
+			p.SiteName = firstNonEmpty(p.SiteName, o.ProviderName)
+			p.ImageURL = firstNonEmpty(p.ImageURL, resolve(base, o.ThumbnailURL))
+		}
+	}
+
+	if p.Title == "" && p.Description == "" && p.ImageURL == "" {
+		return Preview{}, ErrUnsupported
+	}
+
+	return p, nil
+}
+
+type oembed struct {
+	Title        string `json:"title"`
+	ProviderName string `json:"provider_name"`
+	ThumbnailURL string `json:"thumbnail_url"`
+}
+
+func (f *Fetcher) fetchOEmbed(ctx context.Context, rawURL string) (oembed, error) {
+	var o oembed
+
+	resp, err := f.get(ctx, rawURL, "application/json")
+	if err != nil {
+		return o, err
+	}
+	defer resp.Body.Close()
+
+	err = json.NewDecoder(io.LimitReader(resp.Body, f.opts.MaxBodySize)).Decode(&o)
+	return o, err
+}
+
+// FetchImage downloads a thumbnail, images over the size cap are rejected
+func (f *Fetcher) FetchImage(ctx context.Context, rawURL string) (Image, error) {
+	resp, err := f.get(ctx, rawURL, "image/*")
+	if err != nil {
+		return Image{}, err
+	}
+	defer resp.Body.Close()
+
+	contentType := mediaType(resp)
+	if !strings.HasPrefix(contentType, "image/") || contentType == "image/svg+xml" {
+		return Image{}, ErrUnsupported
+	}
+	if resp.ContentLength > f.opts.MaxBodySize {
+		return Image{}, ErrTooLarge
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, f.opts.MaxBodySize+1))
+	if err != nil {
+		return Image{}, err
+	}
+	if int64(len(data)) > f.opts.MaxBodySize {
+		return Image{}, ErrTooLarge
+	}
+
+	return Image{Data: data, ContentType: contentType}, nil
+}
+
+func (f *Fetcher) get(ctx context.Context, rawURL, accept string) (*http.Response, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, ErrUnsupported
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", accept)
+	req.Header.Set("User-Agent", f.opts.UserAgent)
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("unexpected status: %s", resp.Status)
+	}
+
+	return resp, nil
+}
+
+func mediaType(resp *http.Response) string {
+	t, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	return t
+}
+
+// parseHTML collects meta tags, the title and the oEmbed endpoint of the page, keyed by property or name
+func parseHTML(r io.Reader) (map[string]string, error) {
+	meta := map[string]string{}
+	z := html.NewTokenizer(r)
+	inTitle := false
+
+	for {
+		switch z.Next() {
+		case html.ErrorToken:
+			if errors.Is(z.Err(), io.EOF) || errors.Is(z.Err(), io.ErrUnexpectedEOF) {
+				return meta, nil
+			}
+			return meta, z.Err()
+		case html.StartTagToken, html.SelfClosingTagToken:
+			tok := z.Token()
+			switch tok.Data {
+			case "meta":
+				key := strings.ToLower(firstNonEmpty(attr(tok, "property"), attr(tok, "name")))
+				if key != "" && meta[key] == "" {
+					meta[key] = strings.TrimSpace(attr(tok, "content"))
+				}
+			case "link":
+				if attr(tok, "type") == "application/json+oembed" && meta["oembed"] == "" {
+					meta["oembed"] = attr(tok, "href")
+				}
+			case "title":
+				inTitle = true
+			case "body":
+				// Everything we need is in the head
+				return meta, nil
+			}
+		case html.TextToken:
+			if inTitle && meta["title"] == "" {
+				meta["title"] = strings.TrimSpace(string(z.Text()))
+			}
+		case html.EndTagToken:
+			inTitle = false
+		}
+	}
+}
+
+func attr(tok html.Token, name string) string {
+	for _, a := range tok.Attr {
+		if a.Key == name {
+			return a.Val
+		}
+	}
+	return ""
+}
+
+// resolve makes the reference absolute, only http and https URLs are kept
+func resolve(base *url.URL, ref string) string {
+	if ref == "" {
+		return ""
+	}
+	u, err := base.Parse(ref)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return ""
+	}
+	return u.String()
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
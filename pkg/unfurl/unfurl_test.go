@@ -0,0 +1,160 @@
+package unfurl
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func newTestServer(t *testing.T) (*httptest.Server, *atomic.Int32) {
+	var hits atomic.Int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/og", func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Write([]byte(`<html><head>
+			<title>Fallback</title>
+			<meta property="og:title" content="Title">
+			<meta property="og:description" content="Description">
+			<meta property="og:site_name" content="Site">
+			<meta property="og:image" content="/image.png">
+			</head><body><meta property="og:title" content="Ignored"></body></html>`))
+	})
+	mux.HandleFunc("/oembed-page", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.Write([]byte(`<html><head><title>Video</title>
+			<link rel="alternate" type="application/json+oembed" href="/oembed.json">
+			</head></html>`))
+	})
+	mux.HandleFunc("/oembed.json", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"title": "OEmbed title", "provider_name": "Provider", "thumbnail_url": "/thumb.jpg"}`))
+	})
+	mux.HandleFunc("/image.png", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "image/png")
+		w.Write([]byte(strings.Repeat("x", 100)))
+	})
+	mux.HandleFunc("/file.zip", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/zip")
+		w.Write([]byte("PK"))
+	})
+
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv, &hits
+}
+
+func TestFetchOpenGraph(t *testing.T) {
+	srv, hits := newTestServer(t)
+	f := NewFetcher(Options{AllowPrivate: true})
+
+	for range 2 {
+		p, err := f.Fetch(context.Background(), srv.URL+"/og")
+		if err != nil {
+			t.Fatalf("Fetch() error = %v", err)
+		}
+		want := Preview{
+			URL:         srv.URL + "/og",
+			Title:       "Title",
+			Description: "Description",
+			SiteName:    "Site",
+			ImageURL:    srv.URL + "/image.png",
+		}
+		if p != want {
+			t.Errorf("Fetch() = %+v, expected %+v", p, want)
+		}
+	}
+
+	if n := hits.Load(); n != 1 {
+		t.Errorf("page fetched %d times, expected the cached result to be used", n)
+	}
+}
+
+func TestFetchOEmbed(t *testing.T) {
+	srv, _ := newTestServer(t)
+	f := NewFetcher(Options{AllowPrivate: true})
+
+	p, err := f.Fetch(context.Background(), srv.URL+"/oembed-page")
+	if err != nil {
+		t.Fatalf("Fetch() error = %v", err)
+	}
+	if p.Title != "Video" || p.SiteName != "Provider" || p.ImageURL != srv.URL+"/thumb.jpg" {
+		t.Errorf("Fetch() = %+v, expected oEmbed to fill the site name and the thumbnail", p)
+	}
+}
+
+func TestFetchUnsupported(t *testing.T) {
+	srv, _ := newTestServer(t)
+	f := NewFetcher(Options{AllowPrivate: true})
+
+	if _, err := f.Fetch(context.Background(), srv.URL+"/file.zip"); !errors.Is(err, ErrUnsupported) {
+		t.Errorf("Fetch() error = %v, expected %v", err, ErrUnsupported)
+	}
+	if _, err := f.Fetch(context.Background(), "ftp://example.com/"); !errors.Is(err, ErrUnsupported) {
+		t.Errorf("Fetch() error = %v, expected %v", err, ErrUnsupported)
+	}
+}
+
+func TestFetchImageSizeCap(t *testing.T) {
+	srv, _ := newTestServer(t)
+
+	img, err := NewFetcher(Options{AllowPrivate: true}).FetchImage(context.Background(), srv.URL+"/image.png")
+	if err != nil {
+		t.Fatalf("FetchImage() error = %v", err)
+	}
+	if img.ContentType != "image/png" || len(img.Data) != 100 {
+		t.Errorf("FetchImage() = %s with %d bytes", img.ContentType, len(img.Data))
+	}
+
+	_, err = NewFetcher(Options{AllowPrivate: true, MaxBodySize: 50}).FetchImage(context.Background(), srv.URL+"/image.png")
+	if !errors.Is(err, ErrTooLarge) {
+		t.Errorf("FetchImage() error = %v, expected %v", err, ErrTooLarge)
+	}
+}
+
+func TestFetchForbiddenAddress(t *testing.T) {
+	srv, hits := newTestServer(t)
+	f := NewFetcher(Options{})
+
+	if _, err := f.Fetch(context.Background(), srv.URL+"/og"); !errors.Is(err, ErrForbiddenAddress) {
+		t.Errorf("Fetch() error = %v, expected %v", err, ErrForbiddenAddress)
+	}
+	if _, err := f.FetchImage(context.Background(), srv.URL+"/image.png"); !errors.Is(err, ErrForbiddenAddress) {
+		t.Errorf("FetchImage() error = %v, expected %v", err, ErrForbiddenAddress)
+	}
+	if n := hits.Load(); n != 0 {
+		t.Errorf("server received %d requests, expected none", n)
+	}
+}
+
+func TestIsPublicIP(t *testing.T) {
+	for _, tt := range []struct {
+		ip     string
+		public bool
+	}{
+		{"8.8.8.8", true},
+		{"2606:4700::1111", true},
+		{"127.0.0.1", false},
+		{"10.1.2.3", false},
+		{"172.16.0.1", false},
+		{"192.168.1.1", false},
+		{"169.254.169.254", false},
+		{"100.64.0.1", false},
+		{"0.0.0.0", false},
+		{"::1", false},
+		{"fd00::1", false},
+		{"fe80::1", false},
+		{"::ffff:127.0.0.1", false},
+		{"192.88.99.1", false},
+		{"2002:7f00:1::1", false},
+	} {
+		if got := isPublicIP(net.ParseIP(tt.ip)); got != tt.public {
+			t.Errorf("isPublicIP(%s) = %v, expected %v", tt.ip, got, tt.public)
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"log/slog"
+	"time"
 
 	"github.com/confa-chat/node/pkg/uuid"
 	"github.com/confa-chat/node/src/store"
@@ -24,13 +25,16 @@ type AuthenticatorConfig struct {
 	Issuer       string
 	ClientID     string
 	ClientSecret string
+	// DefaultServerID is the server new users join on their first login, none when nil
+	DefaultServerID uuid.UUID
 }
 
 type Authenticator struct {
 	skipAuthMethods []string
 
-	provider rs.ResourceServer
-	db       *bun.DB
+	provider        rs.ResourceServer
+	db              *bun.DB
+	defaultServerID uuid.UUID
 
 	logger *slog.Logger
 }
@@ -46,6 +50,7 @@ func NewAuthenticator(ctx context.Context, db *bun.DB, acfg AuthenticatorConfig,
 		skipAuthMethods: skipAuthMethods,
 		provider:        provider,
 		db:              db,
+		defaultServerID: acfg.DefaultServerID,
 		logger:          slog.With("component", "authenticator"),
 	}, nil
 }
@@ -116,6 +121,19 @@ func (a *Authenticator) createUserFromExternal(ctx context.Context, resp *oidc.I
 			return err
 		}
 
+		if a.defaultServerID != uuid.Nil {
+			_, err = tx.NewInsert().
+				Model(&store.ServerMember{
+					ServerID: a.defaultServerID,
+					UserID:   user.ID,
+					JoinedAt: time.Now(),
+				}).
+				Exec(ctx)
+			if err != nil {
+				return err
+			}
+		}
+
 		return nil
 	})
 	if err != nil {
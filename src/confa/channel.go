@@ -12,8 +12,9 @@ func (c *Service) CreateTextChannel(ctx context.Context, serverID uuid.UUID, nam
 
 	channel := store.TextChannel{
 		ID:       uuid.New(),
-		ServerID: serverID,
+		ServerID: &serverID,
 		Name:     name,
+		Kind:     store.ChannelKindServer,
 	}
 
 	var idrow store.IDRow
@@ -0,0 +1,161 @@
+package confa
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/confa-chat/node/pkg/uuid"
+	"github.com/confa-chat/node/src/store"
+	"github.com/uptrace/bun"
+)
+
+// ErrInvalidDirectMessageUser is returned when opening a direct message with yourself
+var ErrInvalidDirectMessageUser = errors.New("direct messages need another user")
+
+// DirectMessage is a direct or group channel as seen by one of its participants
+type DirectMessage struct {
+	Channel        store.TextChannel
+	ParticipantIDs []uuid.UUID
+	// LastActivityAt is the time of the latest message, or when the channel was opened if it has none
+	LastActivityAt time.Time
+	Unread         ChannelUnread
+}
+
+// directKey identifies the channel of a pair of users regardless of who opened it
+func directKey(a, b uuid.UUID) string {
+	if bytes.Compare(a.Bytes(), b.Bytes()) > 0 {
+		a, b = b, a
+	}
+	return a.String() + ":" + b.String()
+}
+
+// OpenDirectMessage returns the direct channel between two users, creating it on first use
+func (c *Service) OpenDirectMessage(ctx context.Context, userID, otherID uuid.UUID) (DirectMessage, error) {
+	log := c.log.With("user_id", userID, "other_id", otherID)
+
+	if userID == otherID {
+		return DirectMessage{}, ErrInvalidDirectMessageUser
+	}
+	if _, err := c.GetUser(ctx, otherID); err != nil {
+		return DirectMessage{}, err
+	}
+
+	key := directKey(userID, otherID)
+	var channelID uuid.UUID
+	err := c.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		channel := store.TextChannel{
+			ID:        uuid.New(),
+			Kind:      store.ChannelKindDirect,
+			DirectKey: &key,
+		}
+
+		// A concurrent open of the same pair waits for the unique key and then finds the channel below
+		res, err := tx.NewInsert().
+			Model(&channel).
+			On("CONFLICT (direct_key) DO NOTHING").
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		if inserted, _ := res.RowsAffected(); inserted > 0 {
+			now := time.Now()
+			members := []store.ChannelMember{
+				{ChannelID: channel.ID, UserID: userID, JoinedAt: now},
+				{ChannelID: channel.ID, UserID: otherID, JoinedAt: now},
+			}
+			if _, err := tx.NewInsert().Model(&members).Exec(ctx); err != nil {
+				return err
+			}
+		}
+
+		return tx.NewSelect().
+			Model((*store.TextChannel)(nil)).
+			Column("id").
+			Where("direct_key = ?", key).
+			Scan(ctx, &channelID)
+	})
+	if err != nil {
+		log.Error("failed to open direct message", "error", err)
+		return DirectMessage{}, err
+	}
+
+	return c.getDirectMessage(ctx, userID, channelID)
+}
+
+// ListDirectMessages returns the direct and group channels of the user, the most recently active first
+func (c *Service) ListDirectMessages(ctx context.Context, userID uuid.UUID) ([]DirectMessage, error) {
+	dms, err := c.listDirectMessages(ctx, userID, uuid.Nil)
+	if err != nil {
+		c.log.Error("failed to list direct messages", "user_id", userID, "error", err)
+		return nil, err
+	}
+	return dms, nil
+}
+
+// listDirectMessages loads the direct and group channels of the user, or only the given one when channelID is set
+func (c *Service) listDirectMessages(ctx context.Context, userID, channelID uuid.UUID) ([]DirectMessage, error) {
+	var rows []struct {
+		store.TextChannel `bun:",extend"`
+
+		LastActivityAt time.Time `bun:"last_activity_at"`
+	}
+	q := c.db.NewSelect().
+		Model(&rows).
+		Join("JOIN channel_member ON channel_member.channel_id = text_channel.id").
+		ColumnExpr("text_channel.*").
+		ColumnExpr(`COALESCE(
+			(SELECT max(message.timestamp) FROM message WHERE message.channel_id = text_channel.id AND message.deleted_at IS NULL),
+			channel_member.joined_at
+		) AS last_activity_at`).
+		Where("channel_member.user_id = ?", userID).
+		Where("text_channel.kind IN (?)", bun.In([]string{store.ChannelKindDirect, store.ChannelKindGroup})).
+		OrderExpr("last_activity_at DESC, text_channel.id DESC")
+	if channelID != uuid.Nil {
+		q = q.Where("text_channel.id = ?", channelID)
+	}
+	if err := q.Scan(ctx); err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]uuid.UUID, len(rows))
+	for i, row := range rows {
+		ids[i] = row.ID
+	}
+
+	var members []store.ChannelMember
+	err := c.db.NewSelect().
+		Model(&members).
+		Where("channel_id IN (?)", bun.In(ids)).
+		Order("joined_at", "user_id").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	unreads, err := c.listUnreadCounts(ctx, userID, bun.SafeQuery("text_channel.id IN (?)", bun.In(ids)))
+	if err != nil {
+		return nil, err
+	}
+
+	dms := make([]DirectMessage, len(rows))
+	for i, row := range rows {
+		dms[i] = DirectMessage{
+			Channel:        row.TextChannel,
+			LastActivityAt: row.LastActivityAt,
+			Unread:         unreads[row.ID],
+		}
+		for _, member := range members {
+			if member.ChannelID == row.ID {
+				dms[i].ParticipantIDs = append(dms[i].ParticipantIDs, member.UserID)
+			}
+		}
+	}
+
+	return dms, nil
+}
@@ -0,0 +1,203 @@
+package confa
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/confa-chat/node/pkg/markdown"
+	"github.com/confa-chat/node/pkg/uuid"
+	chatv1 "github.com/confa-chat/node/src/proto/confa/chat/v1"
+	"github.com/confa-chat/node/src/store"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// MessageToProto maps a stored message to its API representation
+func MessageToProto(msg store.Message) *chatv1.Message {
+	protoMsg := &chatv1.Message{
+		MessageId: msg.ID.String(),
+		SenderId:  msg.SenderID.String(),
+		Content:   msg.Content,
+		Timestamp: timestamppb.New(msg.Timestamp),
+	}
+
+	if msg.EditedAt != nil {
+		protoMsg.EditedAt = timestamppb.New(*msg.EditedAt)
+	}
+	if msg.DeletedAt != nil {
+		protoMsg.DeletedAt = timestamppb.New(*msg.DeletedAt)
+	}
+	if msg.ExpiresAt != nil {
+		protoMsg.ExpiresAt = timestamppb.New(*msg.ExpiresAt)
+	}
+	if msg.ParentID != nil {
+		protoMsg.ParentMessageId = msg.ParentID.String()
+	}
+	protoMsg.ReplyCount = int32(msg.ReplyCount)
+	if msg.SystemEvent != nil {
+		protoMsg.SystemEvent = systemEventToProto(*msg.SystemEvent)
+	}
+
+	nodes := msg.ContentNodes
+	if nodes == nil && msg.Content != "" {
+		nodes = markdown.Parse(msg.Content)
+	}
+	protoMsg.ContentNodes = contentNodesToProto(nodes)
+
+	for _, reaction := range msg.Reactions {
+		protoMsg.Reactions = append(protoMsg.Reactions, &chatv1.Reaction{
+			Emoji:       reaction.Emoji,
+			Count:       int32(reaction.Count),
+			ReactedByMe: reaction.ReactedByMe,
+		})
+	}
+
+	// Map attachments if any exist
+	if len(msg.Attachments) > 0 {
+		protoMsg.Attachments = make([]*chatv1.Attachment, len(msg.Attachments))
+
+		for i, attachment := range msg.Attachments {
+			protoMsg.Attachments[i] = &chatv1.Attachment{
+				AttachmentId: attachment.AttachmentID.String(),
+				Name:         attachment.Name,
+				Url:          fmt.Sprintf("/attachments/%s/%s", attachment.AttachmentID.String(), attachment.Name),
+			}
+		}
+	}
+
+	previews := slices.Clone(msg.Previews)
+	slices.SortFunc(previews, func(a, b store.LinkPreview) int { return a.Position - b.Position })
+	for _, preview := range previews {
+		protoPreview := &chatv1.LinkPreview{
+			Url:         preview.URL,
+			Title:       preview.Title,
+			Description: preview.Description,
+			SiteName:    preview.SiteName,
+		}
+		if preview.ThumbnailID != nil {
+			protoPreview.ThumbnailUrl = fmt.Sprintf("/attachments/%s/%s", preview.ThumbnailID.String(), preview.ThumbnailName)
+		}
+		protoMsg.Previews = append(protoMsg.Previews, protoPreview)
+	}
+
+	return protoMsg
+}
+
+var contentNodeTypes = map[markdown.NodeType]chatv1.ContentNodeType{
+	markdown.NodeText:      chatv1.ContentNodeType_CONTENT_NODE_TYPE_TEXT,
+	markdown.NodeBold:      chatv1.ContentNodeType_CONTENT_NODE_TYPE_BOLD,
+	markdown.NodeItalic:    chatv1.ContentNodeType_CONTENT_NODE_TYPE_ITALIC,
+	markdown.NodeCode:      chatv1.ContentNodeType_CONTENT_NODE_TYPE_CODE,
+	markdown.NodeCodeBlock: chatv1.ContentNodeType_CONTENT_NODE_TYPE_CODE_BLOCK,
+	markdown.NodeLink:      chatv1.ContentNodeType_CONTENT_NODE_TYPE_LINK,
+	markdown.NodeMention:   chatv1.ContentNodeType_CONTENT_NODE_TYPE_MENTION,
+	markdown.NodeSpoiler:   chatv1.ContentNodeType_CONTENT_NODE_TYPE_SPOILER,
+}
+
+func contentNodesToProto(nodes []markdown.Node) []*chatv1.ContentNode {
+	if len(nodes) == 0 {
+		return nil
+	}
+	res := make([]*chatv1.ContentNode, len(nodes))
+	for i, node := range nodes {
+		res[i] = &chatv1.ContentNode{
+			Type:     contentNodeTypes[node.Type],
+			Text:     node.Text,
+			Url:      node.URL,
+			Language: node.Language,
+			Children: contentNodesToProto(node.Children),
+		}
+	}
+	return res
+}
+
+// publishEvent fans out an event to every subscriber of the topic, a topic is either a channel or a thread.
+// Events are built once and shared between subscribers, so they must not be modified after publishing.
+func (c *Service) publishEvent(topicID uuid.UUID, event *chatv1.MessageEvent) {
+	c.msgBroker.Pub(event, topicID)
+}
+
+// messageTopic returns the topic events of the message are published to
+func messageTopic(msg store.Message) uuid.UUID {
+	if msg.ParentID != nil {
+		return *msg.ParentID
+	}
+	return msg.ChannelID
+}
+
+func (c *Service) publishMessageCreated(msg store.Message) {
+	c.publishEvent(messageTopic(msg), newMessageCreatedEvent(msg))
+}
+
+func (c *Service) publishMessageEdited(msg store.Message) {
+	c.publishEvent(messageTopic(msg), newMessageEditedEvent(msg))
+}
+
+func (c *Service) publishMessageDeleted(msg store.Message) {
+	c.publishEvent(messageTopic(msg), newMessageDeletedEvent(msg))
+}
+
+func newMessageCreatedEvent(msg store.Message) *chatv1.MessageEvent {
+	return &chatv1.MessageEvent{
+		Event: &chatv1.MessageEvent_Created{
+			Created: &chatv1.MessageCreatedEvent{Message: MessageToProto(msg)},
+		},
+	}
+}
+
+func newMessageEditedEvent(msg store.Message) *chatv1.MessageEvent {
+	return &chatv1.MessageEvent{
+		Event: &chatv1.MessageEvent_Edited{
+			Edited: &chatv1.MessageEditedEvent{Message: MessageToProto(msg)},
+		},
+	}
+}
+
+func newMessageDeletedEvent(msg store.Message) *chatv1.MessageEvent {
+	return &chatv1.MessageEvent{
+		Event: &chatv1.MessageEvent_Deleted{
+			Deleted: &chatv1.MessageDeletedEvent{Message: MessageToProto(msg)},
+		},
+	}
+}
+
+func newThreadUpdatedEvent(parent store.Message) *chatv1.MessageEvent {
+	return &chatv1.MessageEvent{
+		Event: &chatv1.MessageEvent_ThreadUpdated{
+			ThreadUpdated: &chatv1.MessageThreadUpdatedEvent{Message: MessageToProto(parent)},
+		},
+	}
+}
+
+func newReactionEvent(msg store.Message, userID uuid.UUID, emoji string, added bool, count int) *chatv1.MessageEvent {
+	return &chatv1.MessageEvent{
+		Event: &chatv1.MessageEvent_Reaction{
+			Reaction: &chatv1.MessageReactionEvent{
+				MessageId: msg.ID.String(),
+				UserId:    userID.String(),
+				Emoji:     emoji,
+				Added:     added,
+				Count:     int32(count),
+			},
+		},
+	}
+}
+
+func newPinEvent(msg store.Message, pinned bool, pinnedBy uuid.UUID) *chatv1.MessageEvent {
+	return &chatv1.MessageEvent{
+		Event: &chatv1.MessageEvent_Pin{
+			Pin: &chatv1.MessagePinEvent{
+				Message:  MessageToProto(msg),
+				Pinned:   pinned,
+				PinnedBy: pinnedBy.String(),
+			},
+		},
+	}
+}
+
+// replayEvent builds the event a subscriber would have received for a message it missed
+func replayEvent(msg store.Message) *chatv1.MessageEvent {
+	if msg.DeletedAt != nil {
+		return newMessageDeletedEvent(msg)
+	}
+	return newMessageCreatedEvent(msg)
+}
@@ -0,0 +1,381 @@
+package confa
+
+import (
+	"archive/zip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"html"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/confa-chat/node/pkg/markdown"
+	"github.com/confa-chat/node/pkg/uuid"
+	"github.com/confa-chat/node/src/store"
+	"github.com/uptrace/bun"
+)
+
+// exportBatchSize is the number of messages loaded from the database at once during an export
+const exportBatchSize = 500
+
+// exportFormatVersion is bumped when the layout of the archive changes
+const exportFormatVersion = 1
+
+// ExportManifest describes the content of a channel export, it is the last file of the archive
+type ExportManifest struct {
+	FormatVersion int                  `json:"format_version"`
+	ServerID      uuid.UUID            `json:"server_id"`
+	ChannelID     uuid.UUID            `json:"channel_id"`
+	ChannelName   string               `json:"channel_name"`
+	ExportedAt    time.Time            `json:"exported_at"`
+	MessageCount  int                  `json:"message_count"`
+	Files         []ExportManifestFile `json:"files"`
+}
+
+// ExportManifestFile is a file of the archive with its SHA-256 checksum
+type ExportManifestFile struct {
+	Path   string `json:"path"`
+	Size   int64  `json:"size"`
+	SHA256 string `json:"sha256"`
+}
+
+type exportMessage struct {
+	ID          uuid.UUID          `json:"id"`
+	ParentID    *uuid.UUID         `json:"parent_id,omitempty"`
+	SenderID    uuid.UUID          `json:"sender_id"`
+	SenderName  string             `json:"sender_name"`
+	Content     string             `json:"content"`
+	Timestamp   time.Time          `json:"timestamp"`
+	EditedAt    *time.Time         `json:"edited_at,omitempty"`
+	DeletedAt   *time.Time         `json:"deleted_at,omitempty"`
+	Attachments []exportAttachment `json:"attachments,omitempty"`
+}
+
+type exportAttachment struct {
+	ID   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
+	// Path is the location of the file in the archive
+	Path string `json:"path"`
+}
+
+// ExportChannel writes the full history of the channel to w as a zip archive with:
+//   - messages.jsonl, one message per line, oldest first, thread replies included
+//   - transcript.html, a static page of the same messages
+//   - attachments/<attachment id>/<name>, the attached files
+//   - manifest.json, the list of files with their checksums
+//
+// Messages are read in batches and written as they are read, so the channel doesn't have to fit in memory.
+func (c *Service) ExportChannel(ctx context.Context, serverID, channelID uuid.UUID, w io.Writer) error {
+	log := c.log.With("server_id", serverID, "channel_id", channelID)
+
+	channel, err := c.GetChannel(ctx, serverID, channelID)
+	if err != nil {
+		return err
+	}
+
+	e := &channelExport{
+		c:       c,
+		zip:     zip.NewWriter(w),
+		channel: channel,
+		users:   map[uuid.UUID]string{},
+		manifest: ExportManifest{
+			FormatVersion: exportFormatVersion,
+			ServerID:      serverID,
+			ChannelID:     channel.ID,
+			ChannelName:   channel.Name,
+			ExportedAt:    time.Now().UTC(),
+		},
+	}
+
+	for _, step := range []func(context.Context) error{
+		e.writeMessages,
+		e.writeTranscript,
+		e.writeAttachments,
+		e.writeManifest,
+	} {
+		if err := step(ctx); err != nil {
+			log.Error("failed to export channel", "error", err)
+			return err
+		}
+	}
+
+	return e.zip.Close()
+}
+
+type channelExport struct {
+	c        *Service
+	zip      *zip.Writer
+	channel  store.TextChannel
+	users    map[uuid.UUID]string
+	manifest ExportManifest
+}
+
+// create starts a new file of the archive, its checksum is added to the manifest when it's closed
+func (e *channelExport) create(name string, modified time.Time) (*exportFile, error) {
+	w, err := e.zip.CreateHeader(&zip.FileHeader{
+		Name:     name,
+		Method:   zip.Deflate,
+		Modified: modified,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	f := &exportFile{export: e, name: name, hash: sha256.New()}
+	f.w = io.MultiWriter(w, f.hash)
+	return f, nil
+}
+
+type exportFile struct {
+	export *channelExport
+	name   string
+	w      io.Writer
+	hash   hash.Hash
+	size   int64
+}
+
+func (f *exportFile) Write(p []byte) (int, error) {
+	n, err := f.w.Write(p)
+	f.size += int64(n)
+	return n, err
+}
+
+func (f *exportFile) Close() {
+	f.export.manifest.Files = append(f.export.manifest.Files, ExportManifestFile{
+		Path:   f.name,
+		Size:   f.size,
+		SHA256: hex.EncodeToString(f.hash.Sum(nil)),
+	})
+}
+
+// eachMessage calls fn for every message of the channel, oldest first
+func (e *channelExport) eachMessage(ctx context.Context, fn func(store.Message) error) error {
+	var last *store.Message
+	for {
+		var messages []store.Message
+		q := e.c.db.NewSelect().
+			Model(&messages).
+			Where("channel_id = ?", e.channel.ID).
+			Relation("Attachments", func(q *bun.SelectQuery) *bun.SelectQuery {
+				return q.Order("attachment_id ASC")
+			}).
+			Order("timestamp ASC", "id ASC").
+			Limit(exportBatchSize)
+		if last != nil {
+			q = q.Where("(timestamp, id) > (?, ?)", last.Timestamp, last.ID)
+		}
+		if err := q.Scan(ctx); err != nil {
+			return err
+		}
+
+		for _, msg := range messages {
+			if err := fn(msg); err != nil {
+				return err
+			}
+		}
+
+		if len(messages) < exportBatchSize {
+			return nil
+		}
+		last = &messages[len(messages)-1]
+	}
+}
+
+func (e *channelExport) senderName(ctx context.Context, id uuid.UUID) string {
+	if name, ok := e.users[id]; ok {
+		return name
+	}
+	user, err := e.c.GetUser(ctx, id)
+	if err != nil {
+		user.Username = id.String()
+	}
+	e.users[id] = user.Username
+	return user.Username
+}
+
+// attachmentPath is the location of the attachment in the archive, it only depends on the attachment
+func attachmentPath(a store.MessageAttachment) string {
+	name := strings.Map(func(r rune) rune {
+		if r == '/' || r == '\\' || r < ' ' {
+			return '_'
+		}
+		return r
+	}, path.Base(a.Name))
+	if name == "." || name == ".." || name == "" {
+		name = "file"
+	}
+	return path.Join("attachments", a.AttachmentID.String(), name)
+}
+
+func (e *channelExport) writeMessages(ctx context.Context) error {
+	f, err := e.create("messages.jsonl", e.manifest.ExportedAt)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	enc := json.NewEncoder(f)
+	return e.eachMessage(ctx, func(msg store.Message) error {
+		e.manifest.MessageCount++
+
+		record := exportMessage{
+			ID:         msg.ID,
+			ParentID:   msg.ParentID,
+			SenderID:   msg.SenderID,
+			SenderName: e.senderName(ctx, msg.SenderID),
+			Content:    msg.Content,
+			Timestamp:  msg.Timestamp.UTC(),
+			EditedAt:   msg.EditedAt,
+			DeletedAt:  msg.DeletedAt,
+		}
+		for _, a := range msg.Attachments {
+			record.Attachments = append(record.Attachments, exportAttachment{
+				ID:   a.AttachmentID,
+				Name: a.Name,
+				Path: attachmentPath(a),
+			})
+		}
+
+		return enc.Encode(record)
+	})
+}
+
+func (e *channelExport) writeTranscript(ctx context.Context) error {
+	f, err := e.create("transcript.html", e.manifest.ExportedAt)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	title := html.EscapeString("#" + e.channel.Name)
+	_, err = fmt.Fprintf(f, `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>%s</title>
+<style>
+body { font-family: sans-serif; max-width: 60em; margin: auto; }
+.message { margin: 0.5em 0; }
+.reply { margin-left: 2em; }
+.meta { color: #666; font-size: 0.85em; }
+.spoiler { background: #333; color: #333; }
+.spoiler:hover { color: #fff; }
+</style>
+</head>
+<body>
+<h1>%s</h1>
+<p class="meta">Exported at %s</p>
+`, title, title, e.manifest.ExportedAt.Format(time.RFC3339))
+	if err != nil {
+		return err
+	}
+
+	err = e.eachMessage(ctx, func(msg store.Message) error {
+		class := "message"
+		if msg.ParentID != nil {
+			class += " reply"
+		}
+		_, err := fmt.Fprintf(f, `<div class="%s" id="m-%s"><div class="meta"><strong>%s</strong> %s</div><div>`,
+			class, msg.ID, html.EscapeString(e.senderName(ctx, msg.SenderID)), msg.Timestamp.UTC().Format(time.RFC3339))
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case msg.DeletedAt != nil:
+			_, err = io.WriteString(f, "<em>deleted</em>")
+		case msg.ContentNodes != nil:
+			err = markdown.RenderHTML(f, msg.ContentNodes)
+		default:
+			err = markdown.RenderHTML(f, markdown.Parse(msg.Content))
+		}
+		if err != nil {
+			return err
+		}
+
+		for _, a := range msg.Attachments {
+			p := attachmentPath(a)
+			_, err = fmt.Fprintf(f, `<div><a href="%s">%s</a></div>`, html.EscapeString(p), html.EscapeString(a.Name))
+			if err != nil {
+				return err
+			}
+		}
+
+		_, err = io.WriteString(f, "</div></div>\n")
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = io.WriteString(f, "</body>\n</html>\n")
+	return err
+}
+
+// writeAttachments copies attached files of the channel from the attachment storage, each file once
+func (e *channelExport) writeAttachments(ctx context.Context) error {
+	var last uuid.UUID
+	for {
+		var attachments []store.MessageAttachment
+		err := e.c.db.NewSelect().
+			Model(&attachments).
+			DistinctOn("message_attachment.attachment_id").
+			Join("JOIN message ON message.id = message_attachment.message_id").
+			Where("message.channel_id = ?", e.channel.ID).
+			Where("message_attachment.attachment_id > ?", last).
+			Order("message_attachment.attachment_id ASC", "message_attachment.id ASC").
+			Limit(exportBatchSize).
+			Scan(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, a := range attachments {
+			if err := e.writeAttachment(ctx, a); err != nil {
+				return err
+			}
+		}
+
+		if len(attachments) < exportBatchSize {
+			return nil
+		}
+		last = attachments[len(attachments)-1].AttachmentID
+	}
+}
+
+func (e *channelExport) writeAttachment(ctx context.Context, a store.MessageAttachment) error {
+	r, err := e.c.attachStorage.Get(ctx, a.AttachmentID)
+	if err != nil {
+		return fmt.Errorf("failed to read attachment %s: %w", a.AttachmentID, err)
+	}
+	defer r.Close()
+
+	f, err := e.create(attachmentPath(a), e.manifest.ExportedAt)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = io.Copy(f, r)
+	return err
+}
+
+func (e *channelExport) writeManifest(ctx context.Context) error {
+	w, err := e.zip.CreateHeader(&zip.FileHeader{
+		Name:     "manifest.json",
+		Method:   zip.Deflate,
+		Modified: e.manifest.ExportedAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(e.manifest)
+}
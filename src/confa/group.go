@@ -0,0 +1,441 @@
+package confa
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/confa-chat/node/pkg/markdown"
+	"github.com/confa-chat/node/pkg/uuid"
+	chatv1 "github.com/confa-chat/node/src/proto/confa/chat/v1"
+	"github.com/confa-chat/node/src/store"
+	"github.com/uptrace/bun"
+)
+
+var (
+	// ErrGroupFull is returned when adding members would exceed the configured group size
+	ErrGroupFull = errors.New("group has reached the maximum number of members")
+	// ErrNotGroup is returned when managing members of a channel which is not a group
+	ErrNotGroup = errors.New("channel is not a group")
+	// ErrInvalidGroupName is returned when the group name is too long
+	ErrInvalidGroupName = errors.New("invalid group name")
+)
+
+// maxGroupNameLength is the maximum length of a group name in characters
+const maxGroupNameLength = 100
+
+func normalizeGroupName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if utf8.RuneCountInString(name) > maxGroupNameLength {
+		return "", ErrInvalidGroupName
+	}
+	return name, nil
+}
+
+// CreateGroup creates a group conversation owned by ownerID with the given members
+func (c *Service) CreateGroup(ctx context.Context, ownerID uuid.UUID, name string, memberIDs []uuid.UUID) (DirectMessage, error) {
+	log := c.log.With("owner_id", ownerID, "member_ids", memberIDs)
+
+	name, err := normalizeGroupName(name)
+	if err != nil {
+		return DirectMessage{}, err
+	}
+
+	var added []uuid.UUID
+	for _, id := range memberIDs {
+		if id != ownerID && !slices.Contains(added, id) {
+			added = append(added, id)
+		}
+	}
+	if len(added) == 0 {
+		return DirectMessage{}, ErrInvalidDirectMessageUser
+	}
+	if 1+len(added) > c.Config.Chat.MaxGroupMembers {
+		return DirectMessage{}, ErrGroupFull
+	}
+
+	channel := store.TextChannel{
+		ID:      uuid.New(),
+		Name:    name,
+		Kind:    store.ChannelKindGroup,
+		OwnerID: &ownerID,
+	}
+
+	var msg store.Message
+	err = c.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if err := checkUsersExist(ctx, tx, added); err != nil {
+			return err
+		}
+
+		if _, err := tx.NewInsert().Model(&channel).Exec(ctx); err != nil {
+			return err
+		}
+
+		if err := addChannelMembers(ctx, tx, channel.ID, append([]uuid.UUID{ownerID}, added...)); err != nil {
+			return err
+		}
+
+		msg, err = postSystemMessage(ctx, tx, channel.ID, ownerID, store.SystemEvent{
+			Type:    store.SystemEventGroupCreated,
+			UserIDs: added,
+			Name:    name,
+		})
+		return err
+	})
+	if err != nil {
+		log.Error("failed to create group", "error", err)
+		return DirectMessage{}, err
+	}
+
+	c.publishMessageCreated(msg)
+
+	return c.getDirectMessage(ctx, ownerID, channel.ID)
+}
+
+// AddGroupMembers adds users to a group, any member can add users.
+// Users who are already members are ignored.
+func (c *Service) AddGroupMembers(ctx context.Context, actorID, channelID uuid.UUID, userIDs []uuid.UUID) (DirectMessage, error) {
+	log := c.log.With("actor_id", actorID, "channel_id", channelID, "user_ids", userIDs)
+
+	var msg *store.Message
+	err := c.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		_, members, err := lockGroup(ctx, tx, channelID, actorID)
+		if err != nil {
+			return err
+		}
+
+		var added []uuid.UUID
+		for _, id := range userIDs {
+			if !slices.Contains(members, id) && !slices.Contains(added, id) {
+				added = append(added, id)
+			}
+		}
+		if len(added) == 0 {
+			return nil
+		}
+		if len(members)+len(added) > c.Config.Chat.MaxGroupMembers {
+			return ErrGroupFull
+		}
+
+		if err := checkUsersExist(ctx, tx, added); err != nil {
+			return err
+		}
+		if err := addChannelMembers(ctx, tx, channelID, added); err != nil {
+			return err
+		}
+
+		posted, err := postSystemMessage(ctx, tx, channelID, actorID, store.SystemEvent{
+			Type:    store.SystemEventMembersAdded,
+			UserIDs: added,
+		})
+		msg = &posted
+		return err
+	})
+	if err != nil {
+		log.Error("failed to add group members", "error", err)
+		return DirectMessage{}, err
+	}
+
+	if msg != nil {
+		c.publishMessageCreated(*msg)
+	}
+
+	return c.getDirectMessage(ctx, actorID, channelID)
+}
+
+// RemoveGroupMember removes a user from a group, only the owner can remove other members
+func (c *Service) RemoveGroupMember(ctx context.Context, actorID, channelID, userID uuid.UUID) error {
+	if actorID == userID {
+		return c.LeaveGroup(ctx, userID, channelID)
+	}
+
+	log := c.log.With("actor_id", actorID, "channel_id", channelID, "user_id", userID)
+
+	var msg store.Message
+	err := c.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		channel, members, err := lockGroup(ctx, tx, channelID, actorID)
+		if err != nil {
+			return err
+		}
+		if channel.OwnerID == nil || *channel.OwnerID != actorID {
+			return ErrPermissionDenied
+		}
+		if !slices.Contains(members, userID) {
+			return sql.ErrNoRows
+		}
+
+		if err := removeChannelMember(ctx, tx, channelID, userID); err != nil {
+			return err
+		}
+
+		msg, err = postSystemMessage(ctx, tx, channelID, actorID, store.SystemEvent{
+			Type:    store.SystemEventMemberRemoved,
+			UserIDs: []uuid.UUID{userID},
+		})
+		return err
+	})
+	if err != nil {
+		log.Error("failed to remove group member", "error", err)
+		return err
+	}
+
+	c.endGroupSubscriptions(channelID, userID)
+	c.publishMessageCreated(msg)
+	return nil
+}
+
+// LeaveGroup removes the user from a group.
+// When the owner leaves, the ownership goes to the member who joined first.
+func (c *Service) LeaveGroup(ctx context.Context, userID, channelID uuid.UUID) error {
+	log := c.log.With("user_id", userID, "channel_id", channelID)
+
+	var msg store.Message
+	err := c.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		channel, members, err := lockGroup(ctx, tx, channelID, userID)
+		if err != nil {
+			return err
+		}
+
+		if err := removeChannelMember(ctx, tx, channelID, userID); err != nil {
+			return err
+		}
+
+		if channel.OwnerID != nil && *channel.OwnerID == userID {
+			// members are ordered by join time, nobody is left to own the group when it is empty
+			var newOwnerID *uuid.UUID
+			if i := slices.IndexFunc(members, func(id uuid.UUID) bool { return id != userID }); i >= 0 {
+				newOwnerID = &members[i]
+			}
+			_, err := tx.NewUpdate().
+				Model((*store.TextChannel)(nil)).
+				Set("owner_id = ?", newOwnerID).
+				Where("id = ?", channelID).
+				Exec(ctx)
+			if err != nil {
+				return err
+			}
+		}
+
+		msg, err = postSystemMessage(ctx, tx, channelID, userID, store.SystemEvent{
+			Type: store.SystemEventMemberLeft,
+		})
+		return err
+	})
+	if err != nil {
+		log.Error("failed to leave group", "error", err)
+		return err
+	}
+
+	c.endGroupSubscriptions(channelID, userID)
+	c.publishMessageCreated(msg)
+	return nil
+}
+
+// endGroupSubscriptions ends the subscriptions of a user who is not a member of the group anymore
+func (c *Service) endGroupSubscriptions(channelID, userID uuid.UUID) {
+	c.members.cancelWhere(channelID, ErrPermissionDenied, func(watch memberWatch) bool {
+		return watch.userID == userID
+	})
+}
+
+// RenameGroup changes the name of a group, any member can rename it
+func (c *Service) RenameGroup(ctx context.Context, actorID, channelID uuid.UUID, name string) (DirectMessage, error) {
+	log := c.log.With("actor_id", actorID, "channel_id", channelID, "name", name)
+
+	name, err := normalizeGroupName(name)
+	if err != nil {
+		return DirectMessage{}, err
+	}
+
+	var msg *store.Message
+	err = c.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		channel, _, err := lockGroup(ctx, tx, channelID, actorID)
+		if err != nil {
+			return err
+		}
+		if channel.Name == name {
+			return nil
+		}
+
+		_, err = tx.NewUpdate().
+			Model((*store.TextChannel)(nil)).
+			Set("name = ?", name).
+			Where("id = ?", channelID).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		posted, err := postSystemMessage(ctx, tx, channelID, actorID, store.SystemEvent{
+			Type: store.SystemEventGroupRenamed,
+			Name: name,
+		})
+		msg = &posted
+		return err
+	})
+	if err != nil {
+		log.Error("failed to rename group", "error", err)
+		return DirectMessage{}, err
+	}
+
+	if msg != nil {
+		c.publishMessageCreated(*msg)
+	}
+
+	return c.getDirectMessage(ctx, actorID, channelID)
+}
+
+// getDirectMessage returns a direct or group channel as seen by the user
+func (c *Service) getDirectMessage(ctx context.Context, userID, channelID uuid.UUID) (DirectMessage, error) {
+	dms, err := c.listDirectMessages(ctx, userID, channelID)
+	if err != nil {
+		c.log.Error("failed to get direct message", "user_id", userID, "channel_id", channelID, "error", err)
+		return DirectMessage{}, err
+	}
+	if len(dms) == 0 {
+		return DirectMessage{}, sql.ErrNoRows
+	}
+	return dms[0], nil
+}
+
+// lockGroup locks the group for a change of its members and returns them, oldest first.
+// The user making the change must be a member.
+func lockGroup(ctx context.Context, tx bun.Tx, channelID, userID uuid.UUID) (store.TextChannel, []uuid.UUID, error) {
+	var channel store.TextChannel
+	err := tx.NewSelect().
+		Model(&channel).
+		Where("id = ?", channelID).
+		For("UPDATE").
+		Scan(ctx)
+	if err != nil {
+		return channel, nil, err
+	}
+	if channel.Kind != store.ChannelKindGroup {
+		return channel, nil, ErrNotGroup
+	}
+
+	var members []uuid.UUID
+	err = tx.NewSelect().
+		Model((*store.ChannelMember)(nil)).
+		Column("user_id").
+		Where("channel_id = ?", channelID).
+		Order("joined_at", "user_id").
+		Scan(ctx, &members)
+	if err != nil {
+		return channel, nil, err
+	}
+	if !slices.Contains(members, userID) {
+		return channel, nil, ErrPermissionDenied
+	}
+
+	return channel, members, nil
+}
+
+func checkUsersExist(ctx context.Context, tx bun.Tx, userIDs []uuid.UUID) error {
+	count, err := tx.NewSelect().
+		Model((*store.User)(nil)).
+		Where("id IN (?)", bun.In(userIDs)).
+		Count(ctx)
+	if err != nil {
+		return err
+	}
+	if count != len(userIDs) {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func addChannelMembers(ctx context.Context, tx bun.Tx, channelID uuid.UUID, userIDs []uuid.UUID) error {
+	now := time.Now()
+	members := make([]store.ChannelMember, len(userIDs))
+	for i, id := range userIDs {
+		members[i] = store.ChannelMember{ChannelID: channelID, UserID: id, JoinedAt: now}
+	}
+	_, err := tx.NewInsert().Model(&members).Exec(ctx)
+	return err
+}
+
+func removeChannelMember(ctx context.Context, tx bun.Tx, channelID, userID uuid.UUID) error {
+	_, err := tx.NewDelete().
+		Model((*store.ChannelMember)(nil)).
+		Where("channel_id = ?", channelID).
+		Where("user_id = ?", userID).
+		Exec(ctx)
+	return err
+}
+
+// postSystemMessage adds a message about a change of the channel to its history.
+// The content is a readable summary for clients which don't render system events.
+func postSystemMessage(ctx context.Context, tx bun.Tx, channelID, actorID uuid.UUID, event store.SystemEvent) (store.Message, error) {
+	var users []store.User
+	err := tx.NewSelect().
+		Model(&users).
+		Where("id IN (?)", bun.In(append([]uuid.UUID{actorID}, event.UserIDs...))).
+		Scan(ctx)
+	if err != nil {
+		return store.Message{}, err
+	}
+
+	usernames := make(map[uuid.UUID]string, len(users))
+	for _, user := range users {
+		usernames[user.ID] = user.Username
+	}
+	names := make([]string, len(event.UserIDs))
+	for i, id := range event.UserIDs {
+		names[i] = usernames[id]
+	}
+
+	var content string
+	switch event.Type {
+	case store.SystemEventGroupCreated:
+		content = usernames[actorID] + " created the group with " + strings.Join(names, ", ")
+	case store.SystemEventMembersAdded:
+		content = usernames[actorID] + " added " + strings.Join(names, ", ")
+	case store.SystemEventMemberRemoved:
+		content = usernames[actorID] + " removed " + strings.Join(names, ", ")
+	case store.SystemEventMemberLeft:
+		content = usernames[actorID] + " left the group"
+	case store.SystemEventGroupRenamed:
+		content = usernames[actorID] + " renamed the group to " + event.Name
+	}
+
+	msg := store.Message{
+		ID:           uuid.New(),
+		ChannelID:    channelID,
+		SenderID:     actorID,
+		Content:      content,
+		ContentNodes: markdown.Parse(content),
+		Timestamp:    time.Now(),
+		SystemEvent:  &event,
+	}
+	_, err = tx.NewInsert().Model(&msg).Exec(ctx)
+	return msg, err
+}
+
+func systemEventToProto(event store.SystemEvent) *chatv1.SystemEvent {
+	protoEvent := &chatv1.SystemEvent{
+		Name: event.Name,
+	}
+	for _, id := range event.UserIDs {
+		protoEvent.UserIds = append(protoEvent.UserIds, id.String())
+	}
+
+	switch event.Type {
+	case store.SystemEventGroupCreated:
+		protoEvent.Type = chatv1.SystemEventType_SYSTEM_EVENT_TYPE_GROUP_CREATED
+	case store.SystemEventMembersAdded:
+		protoEvent.Type = chatv1.SystemEventType_SYSTEM_EVENT_TYPE_MEMBERS_ADDED
+	case store.SystemEventMemberRemoved:
+		protoEvent.Type = chatv1.SystemEventType_SYSTEM_EVENT_TYPE_MEMBER_REMOVED
+	case store.SystemEventMemberLeft:
+		protoEvent.Type = chatv1.SystemEventType_SYSTEM_EVENT_TYPE_MEMBER_LEFT
+	case store.SystemEventGroupRenamed:
+		protoEvent.Type = chatv1.SystemEventType_SYSTEM_EVENT_TYPE_GROUP_RENAMED
+	}
+
+	return protoEvent
+}
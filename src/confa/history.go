@@ -0,0 +1,127 @@
+package confa
+
+import (
+	"context"
+	"slices"
+
+	"github.com/confa-chat/node/pkg/uuid"
+	"github.com/confa-chat/node/src/store"
+)
+
+// HistoryDirection tells which messages around the anchor are returned
+type HistoryDirection int
+
+const (
+	// HistoryBefore returns messages older than the anchor
+	HistoryBefore HistoryDirection = iota
+	// HistoryAfter returns messages newer than the anchor
+	HistoryAfter
+	// HistoryAround returns messages on both sides of the anchor, including the anchor itself
+	HistoryAround
+)
+
+// maxHistoryPageSize is the maximum number of messages in a page of history, larger counts are clamped
+const maxHistoryPageSize = 100
+
+// HistoryPage is a page of channel messages, newest first
+type HistoryPage struct {
+	Messages      []store.Message
+	HasMoreBefore bool
+	HasMoreAfter  bool
+}
+
+// GetMessagesPage returns up to count top-level messages of the channel around the anchor message.
+// Without an anchor the latest messages are returned.
+// Messages are paginated by (timestamp, id) so messages sharing a timestamp are neither skipped nor repeated.
+// The count must be positive and is clamped to maxHistoryPageSize.
+func (c *Service) GetMessagesPage(ctx context.Context, serverID, channelID uuid.UUID, direction HistoryDirection, anchorID uuid.UUID, count int) (HistoryPage, error) {
+	log := c.log.With("server_id", serverID, "channel_id", channelID, "direction", direction, "anchor_id", anchorID, "count", count)
+
+	var page HistoryPage
+	if count <= 0 {
+		return page, ErrInvalidCount
+	}
+	count = min(count, maxHistoryPageSize)
+	if anchorID == uuid.Nil {
+		msgs, more, err := c.getHistorySide(ctx, channelID, nil, "<", count)
+		if err != nil {
+			log.Error("failed to get latest messages", "error", err)
+			return page, err
+		}
+		page.Messages = msgs
+		page.HasMoreBefore = more
+		return page, nil
+	}
+
+	var anchor store.Message
+	err := c.db.NewSelect().
+		Model(&anchor).
+		Column("id", "timestamp").
+		Where("id = ?", anchorID).
+		Where("channel_id = ?", channelID).
+		Scan(ctx)
+	if err != nil {
+		log.Error("failed to get anchor message", "error", err)
+		return page, err
+	}
+
+	var before, after []store.Message
+	switch direction {
+	case HistoryBefore:
+		before, page.HasMoreBefore, err = c.getHistorySide(ctx, channelID, &anchor, "<", count)
+		page.HasMoreAfter = true
+	case HistoryAfter:
+		after, page.HasMoreAfter, err = c.getHistorySide(ctx, channelID, &anchor, ">", count)
+		page.HasMoreBefore = true
+	case HistoryAround:
+		beforeCount := count / 2
+		after, page.HasMoreAfter, err = c.getHistorySide(ctx, channelID, &anchor, ">=", count-beforeCount)
+		if err == nil {
+			before, page.HasMoreBefore, err = c.getHistorySide(ctx, channelID, &anchor, "<", beforeCount)
+		}
+	}
+	if err != nil {
+		log.Error("failed to get messages page", "error", err)
+		return page, err
+	}
+
+	page.Messages = append(after, before...)
+	return page, nil
+}
+
+// getHistorySide loads top-level messages on one side of the anchor, newest first.
+// op compares (timestamp, id) of the messages with the anchor, a nil anchor selects the latest messages.
+func (c *Service) getHistorySide(ctx context.Context, channelID uuid.UUID, anchor *store.Message, op string, count int) ([]store.Message, bool, error) {
+	var messages []store.Message
+	q := c.db.NewSelect().
+		Model(&messages).
+		Where("channel_id = ?", channelID).
+		Where("parent_id IS NULL").
+		Relation("Attachments").
+		Relation("Previews").
+		Limit(count + 1)
+
+	ascending := op == ">" || op == ">="
+	if anchor != nil {
+		q = q.Where("(timestamp, id) "+op+" (?, ?)", anchor.Timestamp, anchor.ID)
+	}
+	if ascending {
+		q = q.Order("timestamp ASC", "id ASC")
+	} else {
+		q = q.Order("timestamp DESC", "id DESC")
+	}
+
+	if err := q.Scan(ctx); err != nil {
+		return nil, false, err
+	}
+
+	hasMore := len(messages) > count
+	if hasMore {
+		messages = messages[:count]
+	}
+	if ascending {
+		slices.Reverse(messages)
+	}
+
+	return messages, hasMore, nil
+}
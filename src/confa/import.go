@@ -0,0 +1,265 @@
+package confa
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/confa-chat/node/pkg/chatimport"
+	"github.com/confa-chat/node/pkg/markdown"
+	"github.com/confa-chat/node/pkg/uuid"
+	"github.com/confa-chat/node/src/store"
+	"github.com/uptrace/bun"
+)
+
+// importBatchSize is the number of messages inserted at once during an import
+const importBatchSize = 500
+
+// ImportResult counts what was created by an import
+type ImportResult struct {
+	ServerID    uuid.UUID
+	Channels    int
+	Users       int
+	Messages    int
+	Attachments int
+}
+
+// importer keeps the state of a single import
+type importer struct {
+	c      *Service
+	src    chatimport.Source
+	result ImportResult
+	// users maps external user IDs to the placeholder users
+	users map[string]uuid.UUID
+}
+
+// importMessage is a message waiting to be inserted with its attachments
+type importMessage struct {
+	msg         store.Message
+	attachments []store.MessageAttachment
+}
+
+// ImportArchive creates a new server owned by ownerID with the channels and the history of an export,
+// the server belongs to the node when ownerID is nil.
+// Authors are mapped to placeholder users named after their name on the source platform,
+// importing the same export again reuses the same users.
+// Messages keep their original timestamps and mentions aren't created, so nobody is notified about old messages.
+func (c *Service) ImportArchive(ctx context.Context, ownerID uuid.UUID, src chatimport.Source) (ImportResult, error) {
+	log := c.log.With("platform", src.Platform(), "server_name", src.ServerName(), "owner_id", ownerID)
+
+	imp := &importer{c: c, src: src, users: map[string]uuid.UUID{}}
+
+	serverID, err := c.CreateServer(ctx, ownerID, src.ServerName())
+	if err != nil {
+		return imp.result, err
+	}
+	imp.result.ServerID = serverID
+
+	for _, ch := range src.Channels() {
+		channelID, err := c.CreateTextChannel(ctx, serverID, ch.Name)
+		if err != nil {
+			return imp.result, err
+		}
+		imp.result.Channels++
+
+		if err := imp.importChannel(ctx, ch, channelID); err != nil {
+			log.Error("failed to import channel", "channel", ch.Name, "error", err)
+			return imp.result, fmt.Errorf("channel %s: %w", ch.Name, err)
+		}
+	}
+
+	log.Info("archive imported", "server_id", serverID, "channels", imp.result.Channels, "users", imp.result.Users,
+		"messages", imp.result.Messages, "attachments", imp.result.Attachments)
+
+	return imp.result, nil
+}
+
+func (imp *importer) importChannel(ctx context.Context, ch chatimport.Channel, channelID uuid.UUID) error {
+	// Thread parents by external ID, only top level messages can be replied to
+	parents := map[string]uuid.UUID{}
+
+	batch := make([]importMessage, 0, importBatchSize)
+	err := imp.src.Messages(ch, func(m chatimport.Message) error {
+		senderID, err := imp.user(ctx, m.Author)
+		if err != nil {
+			return err
+		}
+
+		msg := store.Message{
+			ID:           uuid.NewFromTime(m.Timestamp),
+			ChannelID:    channelID,
+			SenderID:     senderID,
+			Content:      m.Content,
+			ContentNodes: markdown.Parse(m.Content),
+			Timestamp:    m.Timestamp,
+			EditedAt:     m.EditedAt,
+		}
+		if m.ParentExternalID != "" {
+			// Replies to messages which aren't in the export are imported as regular messages
+			if parentID, ok := parents[m.ParentExternalID]; ok {
+				msg.ParentID = &parentID
+			}
+		}
+		if msg.ParentID == nil {
+			parents[m.ExternalID] = msg.ID
+		}
+
+		attachments, err := imp.uploadAttachments(ctx, msg.ID, m.Attachments)
+		if err != nil {
+			return err
+		}
+
+		batch = append(batch, importMessage{msg: msg, attachments: attachments})
+		if len(batch) == importBatchSize {
+			if err := imp.insertBatch(ctx, batch); err != nil {
+				return err
+			}
+			batch = batch[:0]
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := imp.insertBatch(ctx, batch); err != nil {
+		return err
+	}
+
+	// Replies can be in a later batch than their parent, so the counters are computed once the channel is complete
+	_, err = imp.c.db.NewUpdate().
+		Model((*store.Message)(nil)).
+		TableExpr("(SELECT parent_id, count(*) AS count FROM message WHERE channel_id = ? AND parent_id IS NOT NULL GROUP BY parent_id) AS replies", channelID).
+		Set("reply_count = replies.count").
+		Where("message.id = replies.parent_id").
+		Exec(ctx)
+	return err
+}
+
+func (imp *importer) insertBatch(ctx context.Context, batch []importMessage) error {
+	if len(batch) == 0 {
+		return nil
+	}
+
+	messages := make([]store.Message, 0, len(batch))
+	var attachments []store.MessageAttachment
+	for _, m := range batch {
+		messages = append(messages, m.msg)
+		attachments = append(attachments, m.attachments...)
+	}
+
+	err := imp.c.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.NewInsert().Model(&messages).Exec(ctx); err != nil {
+			return err
+		}
+		if len(attachments) > 0 {
+			if _, err := tx.NewInsert().Model(&attachments).Exec(ctx); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	imp.result.Messages += len(messages)
+	imp.result.Attachments += len(attachments)
+	return nil
+}
+
+// uploadAttachments stores the files of a message, files which can't be read from the export are skipped
+func (imp *importer) uploadAttachments(ctx context.Context, msgID uuid.UUID, files []chatimport.Attachment) ([]store.MessageAttachment, error) {
+	var attachments []store.MessageAttachment
+	for _, a := range files {
+		r, err := imp.src.Open(a)
+		if err != nil {
+			imp.c.log.Warn("skipping attachment missing from the export", "path", a.Path, "error", err)
+			continue
+		}
+
+		info, err := imp.c.attachStorage.Upload(ctx, a.Name, r)
+		r.Close()
+		if err != nil {
+			imp.c.log.Error("failed to upload attachment", "path", a.Path, "error", err)
+			return nil, err
+		}
+
+		attachments = append(attachments, store.MessageAttachment{
+			ID:           uuid.New(),
+			MessageID:    msgID,
+			Name:         a.Name,
+			AttachmentID: info.ID,
+		})
+	}
+	return attachments, nil
+}
+
+// user returns the placeholder user of an author, creating it on first use
+func (imp *importer) user(ctx context.Context, u chatimport.User) (uuid.UUID, error) {
+	if id, ok := imp.users[u.ExternalID]; ok {
+		return id, nil
+	}
+
+	user := store.User{
+		ID:       uuid.New(),
+		Username: placeholderUsername(imp.src.Platform(), u),
+	}
+
+	// The no-op update makes RETURNING yield the ID of a user created by a previous import
+	var idrow store.IDRow
+	_, err := imp.c.db.NewInsert().
+		Model(&user).
+		On("CONFLICT (username) DO UPDATE").
+		Set("username = EXCLUDED.username").
+		Returning("id").
+		Exec(ctx, &idrow)
+	if err != nil {
+		imp.c.log.Error("failed to create placeholder user", "username", user.Username, "error", err)
+		return uuid.Nil, err
+	}
+
+	member := store.ServerMember{
+		ServerID: imp.result.ServerID,
+		UserID:   idrow.ID,
+		JoinedAt: time.Now(),
+	}
+	_, err = imp.c.db.NewInsert().
+		Model(&member).
+		On("CONFLICT DO NOTHING").
+		Exec(ctx)
+	if err != nil {
+		imp.c.log.Error("failed to add placeholder user to the server", "username", user.Username, "error", err)
+		return uuid.Nil, err
+	}
+
+	imp.users[u.ExternalID] = idrow.ID
+	imp.result.Users++
+	return idrow.ID, nil
+}
+
+// placeholderUsername is the username of an imported author, the platform and external ID keep it unique
+func placeholderUsername(platform string, u chatimport.User) string {
+	name := strings.Map(func(r rune) rune {
+		switch {
+		case unicode.IsLetter(r), unicode.IsDigit(r), r == '_', r == '-':
+			return unicode.ToLower(r)
+		case unicode.IsSpace(r), r == '.':
+			return '_'
+		default:
+			return -1
+		}
+	}, u.Name)
+
+	// Leave room for the suffix, the column is limited to 255 characters
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64])
+	}
+	if name == "" {
+		name = "user"
+	}
+
+	return fmt.Sprintf("%s.%s-%s", name, platform, strings.ToLower(u.ExternalID))
+}
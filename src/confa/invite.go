@@ -0,0 +1,208 @@
+package confa
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"time"
+
+	"github.com/confa-chat/node/pkg/uuid"
+	"github.com/confa-chat/node/src/store"
+	"github.com/uptrace/bun"
+)
+
+// inviteCodeBytes is the number of random bytes in an invite code, encoded as 12 URL-safe characters
+const inviteCodeBytes = 9
+
+var (
+	// ErrInvalidInvite is returned when redeeming an invite which doesn't exist, was revoked, expired or was used up
+	ErrInvalidInvite = errors.New("invite is invalid or expired")
+	// ErrInvalidInviteOptions is returned when creating an invite with a negative expiry or number of uses
+	ErrInvalidInviteOptions = errors.New("invalid invite options")
+)
+
+// InviteOptions limits how an invite can be used
+type InviteOptions struct {
+	// ChannelID is the channel shown to users joining with the invite, none when nil
+	ChannelID *uuid.UUID
+	// MaxUses is the number of times the invite can be redeemed, 0 for unlimited
+	MaxUses int
+	// ExpiresIn is how long the invite can be redeemed for, 0 for no expiry
+	ExpiresIn time.Duration
+}
+
+// newInviteCode returns a random code which is short enough to share and can't be guessed
+func newInviteCode() string {
+	b := make([]byte, inviteCodeBytes)
+	rand.Read(b)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// CreateInvite creates an invite to the server, the user needs the permission to create invites
+func (c *Service) CreateInvite(ctx context.Context, userID, serverID uuid.UUID, opts InviteOptions) (store.ServerInvite, error) {
+	log := c.log.With("user_id", userID, "server_id", serverID)
+
+	var invite store.ServerInvite
+	if opts.MaxUses < 0 || opts.ExpiresIn < 0 {
+		return invite, ErrInvalidInviteOptions
+	}
+	if err := c.CheckPermission(ctx, userID, serverID, uuid.Nil, PermissionCreateInvites); err != nil {
+		return invite, err
+	}
+
+	if opts.ChannelID != nil {
+		exists, err := c.db.NewSelect().
+			Model((*store.TextChannel)(nil)).
+			Where("id = ?", *opts.ChannelID).
+			Where("server_id = ?", serverID).
+			Exists(ctx)
+		if err != nil {
+			log.Error("failed to get invite channel", "error", err)
+			return invite, err
+		}
+		if !exists {
+			return invite, sql.ErrNoRows
+		}
+	}
+
+	now := time.Now()
+	invite = store.ServerInvite{
+		Code:      newInviteCode(),
+		ServerID:  serverID,
+		ChannelID: opts.ChannelID,
+		CreatorID: userID,
+		MaxUses:   opts.MaxUses,
+		CreatedAt: now,
+	}
+	if opts.ExpiresIn > 0 {
+		expiresAt := now.Add(opts.ExpiresIn)
+		invite.ExpiresAt = &expiresAt
+	}
+
+	_, err := c.db.NewInsert().
+		Model(&invite).
+		Exec(ctx)
+	if err != nil {
+		log.Error("failed to create invite", "error", err)
+		return invite, err
+	}
+
+	return invite, nil
+}
+
+// ListInvites returns the invites to the server which can still be redeemed, the newest first.
+// The user needs the permission to manage the server.
+func (c *Service) ListInvites(ctx context.Context, userID, serverID uuid.UUID) ([]store.ServerInvite, error) {
+	if err := c.CheckPermission(ctx, userID, serverID, uuid.Nil, PermissionManageServer); err != nil {
+		return nil, err
+	}
+
+	var invites []store.ServerInvite
+	err := c.db.NewSelect().
+		Model(&invites).
+		Where("server_id = ?", serverID).
+		Where("revoked_at IS NULL").
+		Where("expires_at IS NULL OR expires_at > now()").
+		Where("max_uses = 0 OR uses < max_uses").
+		Order("created_at DESC").
+		Scan(ctx)
+	if err != nil {
+		c.log.Error("failed to list invites", "server_id", serverID, "error", err)
+		return nil, err
+	}
+
+	return invites, nil
+}
+
+// RevokeInvite stops the invite from being redeemed, only its creator or a user managing the server can revoke it
+func (c *Service) RevokeInvite(ctx context.Context, userID uuid.UUID, code string) error {
+	log := c.log.With("user_id", userID, "code", code)
+
+	var invite store.ServerInvite
+	err := c.db.NewSelect().
+		Model(&invite).
+		Where("code = ?", code).
+		Scan(ctx)
+	if err != nil {
+		log.Error("failed to get invite", "error", err)
+		return err
+	}
+
+	if invite.CreatorID != userID && !c.hasPermission(ctx, userID, invite.ServerID, uuid.Nil, PermissionManageServer) {
+		return ErrPermissionDenied
+	}
+
+	_, err = c.db.NewUpdate().
+		Model((*store.ServerInvite)(nil)).
+		Set("revoked_at = now()").
+		Where("code = ?", code).
+		Where("revoked_at IS NULL").
+		Exec(ctx)
+	if err != nil {
+		log.Error("failed to revoke invite", "error", err)
+		return err
+	}
+
+	return nil
+}
+
+// RedeemInvite makes the user a member of the server of the invite.
+// Members of the server can redeem an invite again without using it up, banned users can't redeem it.
+func (c *Service) RedeemInvite(ctx context.Context, userID uuid.UUID, code string) (store.ServerInvite, store.ServerMember, error) {
+	log := c.log.With("user_id", userID, "code", code)
+
+	var invite store.ServerInvite
+	err := c.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		// Concurrent redemptions of the same invite wait here, so the last use can't be taken twice
+		err := tx.NewSelect().
+			Model(&invite).
+			Where("code = ?", code).
+			For("UPDATE").
+			Scan(ctx)
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrInvalidInvite
+		}
+		if err != nil {
+			return err
+		}
+
+		if invite.RevokedAt != nil ||
+			(invite.ExpiresAt != nil && !invite.ExpiresAt.After(time.Now())) ||
+			(invite.MaxUses > 0 && invite.Uses >= invite.MaxUses) {
+			return ErrInvalidInvite
+		}
+
+		banned, err := isBanned(ctx, tx, invite.ServerID, userID)
+		if err != nil {
+			return err
+		}
+		if banned {
+			return ErrBanned
+		}
+
+		joined, err := joinServer(ctx, tx, invite.ServerID, userID)
+		if err != nil || !joined {
+			return err
+		}
+
+		invite.Uses++
+		_, err = tx.NewUpdate().
+			Model(&invite).
+			Column("uses").
+			WherePK().
+			Exec(ctx)
+		return err
+	})
+	if errors.Is(err, ErrInvalidInvite) || errors.Is(err, ErrBanned) {
+		return invite, store.ServerMember{}, err
+	}
+	if err != nil {
+		log.Error("failed to redeem invite", "error", err)
+		return invite, store.ServerMember{}, err
+	}
+
+	member, err := c.getServerMember(ctx, invite.ServerID, userID)
+	return invite, member, err
+}
@@ -0,0 +1,197 @@
+package confa
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/confa-chat/node/pkg/uuid"
+	"github.com/confa-chat/node/src/store"
+	"github.com/uptrace/bun"
+)
+
+var (
+	// ErrNotServerMember is returned when leaving or changing the membership of a server the user didn't join
+	ErrNotServerMember = errors.New("user is not a member of the server")
+	// ErrInvalidNickname is returned when the nickname is too long
+	ErrInvalidNickname = errors.New("invalid nickname")
+	// ErrServerOwner is returned when the owner leaves a server without transferring it first
+	ErrServerOwner = errors.New("the owner can't leave the server")
+)
+
+// maxNicknameLength is the maximum length of a nickname in characters
+const maxNicknameLength = 64
+
+// joinServer makes the user a member of the server, joining a server twice keeps the first membership.
+// It reports whether the user wasn't a member before.
+func joinServer(ctx context.Context, db bun.IDB, serverID, userID uuid.UUID) (bool, error) {
+	member := store.ServerMember{
+		ServerID: serverID,
+		UserID:   userID,
+		JoinedAt: time.Now(),
+	}
+	res, err := db.NewInsert().
+		Model(&member).
+		On("CONFLICT DO NOTHING").
+		Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	inserted, _ := res.RowsAffected()
+	return inserted > 0, nil
+}
+
+// LeaveServer removes the membership of the user
+func (c *Service) LeaveServer(ctx context.Context, serverID, userID uuid.UUID) error {
+	log := c.log.With("server_id", serverID, "user_id", userID)
+
+	isOwner, err := c.db.NewSelect().
+		Model((*store.Server)(nil)).
+		Where("id = ?", serverID).
+		Where("owner_id = ?", userID).
+		Exists(ctx)
+	if err != nil {
+		log.Error("failed to get server owner", "error", err)
+		return err
+	}
+	if isOwner {
+		return ErrServerOwner
+	}
+
+	res, err := c.db.NewDelete().
+		Model((*store.ServerMember)(nil)).
+		Where("server_id = ?", serverID).
+		Where("user_id = ?", userID).
+		Exec(ctx)
+	if err != nil {
+		log.Error("failed to leave server", "error", err)
+		return err
+	}
+
+	if deleted, _ := res.RowsAffected(); deleted == 0 {
+		return ErrNotServerMember
+	}
+
+	c.members.evict(serverID, userID)
+
+	return nil
+}
+
+// SetServerNickname changes the name the user is shown with on the server, an empty nickname shows the username
+func (c *Service) SetServerNickname(ctx context.Context, serverID, userID uuid.UUID, nickname string) (store.ServerMember, error) {
+	log := c.log.With("server_id", serverID, "user_id", userID, "nickname", nickname)
+
+	nickname = strings.TrimSpace(nickname)
+	if utf8.RuneCountInString(nickname) > maxNicknameLength {
+		return store.ServerMember{}, ErrInvalidNickname
+	}
+
+	res, err := c.db.NewUpdate().
+		Model((*store.ServerMember)(nil)).
+		Set("nickname = NULLIF(?, '')", nickname).
+		Where("server_id = ?", serverID).
+		Where("user_id = ?", userID).
+		Exec(ctx)
+	if err != nil {
+		log.Error("failed to set nickname", "error", err)
+		return store.ServerMember{}, err
+	}
+	if updated, _ := res.RowsAffected(); updated == 0 {
+		return store.ServerMember{}, ErrNotServerMember
+	}
+
+	return c.getServerMember(ctx, serverID, userID)
+}
+
+func (c *Service) getServerMember(ctx context.Context, serverID, userID uuid.UUID) (store.ServerMember, error) {
+	var member store.ServerMember
+	err := c.db.NewSelect().
+		Model(&member).
+		Relation("User").
+		Where("server_member.server_id = ?", serverID).
+		Where("server_member.user_id = ?", userID).
+		Scan(ctx)
+	if err != nil {
+		c.log.Error("failed to get server member", "server_id", serverID, "user_id", userID, "error", err)
+		return member, err
+	}
+
+	members := []store.ServerMember{member}
+	if err := c.listMemberRoles(ctx, serverID, members); err != nil {
+		c.log.Error("failed to list member roles", "server_id", serverID, "user_id", userID, "error", err)
+		return member, err
+	}
+	return members[0], nil
+}
+
+// ListServerMembers returns the members of the server with their users, oldest members first
+func (c *Service) ListServerMembers(ctx context.Context, serverID uuid.UUID) ([]store.ServerMember, error) {
+	var members []store.ServerMember
+	err := c.db.NewSelect().
+		Model(&members).
+		Relation("User").
+		Where("server_member.server_id = ?", serverID).
+		Order("server_member.joined_at", "server_member.user_id").
+		Scan(ctx)
+	if err != nil {
+		c.log.Error("failed to list server members", "server_id", serverID, "error", err)
+		return nil, err
+	}
+	if err := c.listMemberRoles(ctx, serverID, members); err != nil {
+		c.log.Error("failed to list member roles", "server_id", serverID, "error", err)
+		return nil, err
+	}
+	return members, nil
+}
+
+// ListUserServers returns the servers the user is a member of
+func (c *Service) ListUserServers(ctx context.Context, userID uuid.UUID) ([]store.Server, error) {
+	var servers []store.Server
+	err := c.db.NewSelect().
+		Model(&servers).
+		Join("JOIN server_member ON server_member.server_id = server.id").
+		Where("server_member.user_id = ?", userID).
+		Order("server_member.joined_at").
+		Scan(ctx)
+	if err != nil {
+		c.log.Error("failed to list user servers", "user_id", userID, "error", err)
+		return nil, err
+	}
+	return servers, nil
+}
+
+// CheckServerAccess returns ErrPermissionDenied when the user is not a member of the server
+func (c *Service) CheckServerAccess(ctx context.Context, userID, serverID uuid.UUID) error {
+	isMember, err := c.db.NewSelect().
+		Model((*store.ServerMember)(nil)).
+		Where("server_id = ?", serverID).
+		Where("user_id = ?", userID).
+		Exists(ctx)
+	if err != nil {
+		c.log.Error("failed to check server access", "user_id", userID, "server_id", serverID, "error", err)
+		return err
+	}
+	if !isMember {
+		return ErrPermissionDenied
+	}
+	return nil
+}
+
+// channelMembers returns the users who can access the channel:
+// the members of its server, or the members of the channel itself for channels outside of servers
+func channelMembers(ctx context.Context, db bun.IDB, channelID uuid.UUID) ([]uuid.UUID, error) {
+	var members []uuid.UUID
+	err := db.NewRaw(`
+		SELECT server_member.user_id FROM server_member
+		JOIN text_channel ON text_channel.server_id = server_member.server_id
+		WHERE text_channel.id = ?0
+		UNION
+		SELECT channel_member.user_id FROM channel_member
+		WHERE channel_member.channel_id = ?0`,
+		channelID,
+	).Scan(ctx, &members)
+	return members, err
+}
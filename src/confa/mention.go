@@ -0,0 +1,275 @@
+package confa
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/confa-chat/node/pkg/markdown"
+	"github.com/confa-chat/node/pkg/uuid"
+	chatv1 "github.com/confa-chat/node/src/proto/confa/chat/v1"
+	"github.com/confa-chat/node/src/store"
+	"github.com/cskr/pubsub/v2"
+	"github.com/uptrace/bun"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type parsedMentions struct {
+	Usernames []string
+	Everyone  bool
+	// Channel is set by @here and @channel, which address everyone taking part in the channel
+	Channel bool
+}
+
+// parseMentions collects mentions from the markdown tree of the content, so names inside code are not mentions
+func parseMentions(content string) parsedMentions {
+	var res parsedMentions
+	seen := map[string]bool{}
+
+	var walk func(nodes []markdown.Node)
+	walk = func(nodes []markdown.Node) {
+		for _, node := range nodes {
+			if node.Type != markdown.NodeMention {
+				walk(node.Children)
+				continue
+			}
+
+			switch node.Text {
+			case "everyone":
+				res.Everyone = true
+			case "here", "channel":
+				res.Channel = true
+			default:
+				if !seen[node.Text] {
+					seen[node.Text] = true
+					res.Usernames = append(res.Usernames, node.Text)
+				}
+			}
+		}
+	}
+	walk(markdown.Parse(content))
+
+	return res
+}
+
+// createMentions resolves mentions in the message content and stores them for every addressed user
+func (c *Service) createMentions(ctx context.Context, tx bun.Tx, serverID uuid.UUID, msg store.Message) ([]store.Mention, error) {
+	parsed := parseMentions(msg.Content)
+
+	// A user addressed in several ways gets a single mention of the most specific kind
+	kinds := map[uuid.UUID]string{}
+
+	if parsed.Everyone {
+		members, err := channelMembers(ctx, tx, msg.ChannelID)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range members {
+			kinds[id] = store.MentionKindEveryone
+		}
+	}
+
+	if parsed.Channel {
+		var userIDs []uuid.UUID
+		err := tx.NewSelect().
+			Model((*store.Message)(nil)).
+			ColumnExpr("DISTINCT sender_id").
+			Where("channel_id = ?", msg.ChannelID).
+			Scan(ctx, &userIDs)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range userIDs {
+			kinds[id] = store.MentionKindChannel
+		}
+	}
+
+	if len(parsed.Usernames) > 0 {
+		var users []store.User
+		err := tx.NewSelect().
+			Model(&users).
+			Where("username IN (?)", bun.In(parsed.Usernames)).
+			Scan(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, user := range users {
+			kinds[user.ID] = store.MentionKindUser
+		}
+	}
+
+	delete(kinds, msg.SenderID)
+	if len(kinds) == 0 {
+		return nil, nil
+	}
+
+	// Users who can't view the channel are not notified about its messages
+	addressed := make([]uuid.UUID, 0, len(kinds))
+	for id := range kinds {
+		addressed = append(addressed, id)
+	}
+	viewers, err := c.channelViewers(ctx, tx, msg.ChannelID, addressed)
+	if err != nil {
+		return nil, err
+	}
+	if len(viewers) == 0 {
+		return nil, nil
+	}
+
+	mentions := make([]store.Mention, 0, len(viewers))
+	for _, userID := range viewers {
+		kind := kinds[userID]
+		mentions = append(mentions, store.Mention{
+			ID:        uuid.New(),
+			MessageID: msg.ID,
+			UserID:    userID,
+			ChannelID: msg.ChannelID,
+			Kind:      kind,
+			CreatedAt: msg.Timestamp,
+		})
+	}
+
+	if _, err := tx.NewInsert().Model(&mentions).Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	return mentions, nil
+}
+
+// MentionToProto maps a stored mention to its API representation,
+// the server ID is left empty for channels outside of servers
+func MentionToProto(mention store.Mention, serverID uuid.UUID, msg store.Message) *chatv1.Mention {
+	kind := chatv1.MentionKind_MENTION_KIND_USER
+	switch mention.Kind {
+	case store.MentionKindEveryone:
+		kind = chatv1.MentionKind_MENTION_KIND_EVERYONE
+	case store.MentionKindChannel:
+		kind = chatv1.MentionKind_MENTION_KIND_CHANNEL
+	}
+
+	protoMention := &chatv1.Mention{
+		MentionId: mention.ID.String(),
+		Channel: &chatv1.TextChannelRef{
+			ChannelId: mention.ChannelID.String(),
+		},
+		Message:      MessageToProto(msg),
+		Kind:         kind,
+		Timestamp:    timestamppb.New(mention.CreatedAt),
+		Acknowledged: mention.AcknowledgedAt != nil,
+	}
+	if serverID != uuid.Nil {
+		protoMention.Channel.ServerId = serverID.String()
+	}
+	return protoMention
+}
+
+func (c *Service) publishMentions(serverID uuid.UUID, msg store.Message, mentions []store.Mention) {
+	for _, mention := range mentions {
+		c.mentionBroker.Pub(MentionToProto(mention, serverID, msg), mention.UserID)
+	}
+}
+
+// ListMentions returns mentions of the user older than the given mention, newest first.
+// count must be positive and is capped at maxHistoryPageSize.
+func (c *Service) ListMentions(ctx context.Context, userID, beforeID uuid.UUID, unacknowledgedOnly bool, count int) ([]store.Mention, bool, error) {
+	if count <= 0 {
+		return nil, false, ErrInvalidCount
+	}
+	count = min(count, maxHistoryPageSize)
+
+	log := c.log.With("user_id", userID, "before_id", beforeID, "unacknowledged_only", unacknowledgedOnly, "count", count)
+
+	var mentions []store.Mention
+	q := c.db.NewSelect().
+		Model(&mentions).
+		Relation("Channel").
+		Where("mention.user_id = ?", userID)
+	if beforeID != uuid.Nil {
+		q = q.Where("mention.id < ?", beforeID)
+	}
+	if unacknowledgedOnly {
+		q = q.Where("mention.acknowledged_at IS NULL")
+	}
+	err := q.
+		Order("mention.id DESC").
+		Limit(count + 1).
+		Scan(ctx)
+	if err != nil {
+		log.Error("failed to list mentions", "error", err)
+		return nil, false, err
+	}
+
+	hasMore := len(mentions) > count
+	if hasMore {
+		mentions = mentions[:count]
+	}
+
+	// Mentions in channels the user can't view anymore are filtered out here, a page can come out shorter
+	visible := map[uuid.UUID]bool{}
+	mentions = slices.DeleteFunc(mentions, func(mention store.Mention) bool {
+		canView, checked := visible[mention.ChannelID]
+		if !checked {
+			canView = c.hasPermission(ctx, userID, uuid.Nil, mention.ChannelID, PermissionViewChannel)
+			visible[mention.ChannelID] = canView
+		}
+		return !canView
+	})
+
+	ids := make([]uuid.UUID, 0, len(mentions))
+	for _, mention := range mentions {
+		ids = append(ids, mention.MessageID)
+	}
+	messages, err := c.getMessagesByIDs(ctx, ids)
+	if err != nil {
+		return nil, false, err
+	}
+	for i := range mentions {
+		msg := messages[mentions[i].MessageID]
+		mentions[i].Message = &msg
+	}
+
+	return mentions, hasMore, nil
+}
+
+// AcknowledgeMentions marks mentions of the user as seen, all of them when mentionIDs is empty
+func (c *Service) AcknowledgeMentions(ctx context.Context, userID uuid.UUID, mentionIDs []uuid.UUID) error {
+	q := c.db.NewUpdate().
+		Model((*store.Mention)(nil)).
+		Set("acknowledged_at = ?", time.Now()).
+		Where("user_id = ?", userID).
+		Where("acknowledged_at IS NULL")
+	if len(mentionIDs) > 0 {
+		q = q.Where("id IN (?)", bun.In(mentionIDs))
+	}
+
+	_, err := q.Exec(ctx)
+	if err != nil {
+		c.log.Error("failed to acknowledge mentions", "user_id", userID, "mention_ids", mentionIDs, "error", err)
+		return err
+	}
+
+	return nil
+}
+
+type MentionSubscription struct {
+	UserID   uuid.UUID
+	Mentions chan *chatv1.Mention
+
+	mentionBroker *pubsub.PubSub[uuid.UUID, *chatv1.Mention]
+}
+
+func (m *MentionSubscription) Close() {
+	m.mentionBroker.Unsub(m.Mentions, m.UserID)
+	// Drain the channel
+	for range m.Mentions {
+	}
+}
+
+// SubscribeMentions subscribes to new mentions of the user
+func (c *Service) SubscribeMentions(ctx context.Context, userID uuid.UUID) (*MentionSubscription, error) {
+	return &MentionSubscription{
+		UserID:        userID,
+		Mentions:      c.mentionBroker.Sub(userID),
+		mentionBroker: c.mentionBroker,
+	}, nil
+}
@@ -0,0 +1,39 @@
+package confa
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestParseMentions(t *testing.T) {
+	tests := []struct {
+		content   string
+		usernames []string
+		everyone  bool
+		channel   bool
+	}{
+		{content: "hello world"},
+		{content: "@alice hi", usernames: []string{"alice"}},
+		{content: "hi @alice and @bob.", usernames: []string{"alice", "bob"}},
+		{content: "ping @alice @alice", usernames: []string{"alice"}},
+		{content: "mail me at alice@example.com"},
+		{content: "@everyone release is out", everyone: true},
+		{content: "(@here) standup", channel: true},
+		{content: "@channel, @john.doe-", usernames: []string{"john.doe"}, channel: true},
+		{content: "@"},
+		{content: "`@alice` **@bob**", usernames: []string{"bob"}},
+	}
+
+	for _, tt := range tests {
+		got := parseMentions(tt.content)
+		if !slices.Equal(got.Usernames, tt.usernames) {
+			t.Errorf("parseMentions(%q) usernames = %v, expected %v", tt.content, got.Usernames, tt.usernames)
+		}
+		if got.Everyone != tt.everyone {
+			t.Errorf("parseMentions(%q) everyone = %v, expected %v", tt.content, got.Everyone, tt.everyone)
+		}
+		if got.Channel != tt.channel {
+			t.Errorf("parseMentions(%q) channel = %v, expected %v", tt.content, got.Channel, tt.channel)
+		}
+	}
+}
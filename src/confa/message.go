@@ -2,47 +2,43 @@ package confa
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
+	"slices"
 	"time"
 
+	"github.com/confa-chat/node/pkg/markdown"
 	"github.com/confa-chat/node/pkg/uuid"
 	"github.com/confa-chat/node/src/store"
-	"github.com/cskr/pubsub/v2"
+	"github.com/uptrace/bun"
 )
 
-type ChannelSubscription struct {
-	ChannelID uuid.UUID
-	Msgs      chan uuid.UUID
-
-	msgBroker *pubsub.PubSub[uuid.UUID, uuid.UUID]
-}
+var (
+	// ErrNotMessageAuthor is returned when a user tries to change a message sent by someone else, or see its edits
+	ErrNotMessageAuthor = errors.New("user is not the author of the message")
+	// ErrMessageDeleted is returned when trying to change a message that was already deleted
+	ErrMessageDeleted = errors.New("message is deleted")
+	// ErrSystemMessage is returned when trying to edit a message posted by the node about a change of the channel
+	ErrSystemMessage = errors.New("system messages can't be edited")
+)
 
-func (c *ChannelSubscription) Close() {
-	c.msgBroker.Unsub(c.Msgs, c.ChannelID)
-	// Drain the channel
-	for range c.Msgs {
+func (c *Service) GetMessagesHistory(ctx context.Context, serverID uuid.UUID, channelID uuid.UUID, from time.Time, count int) ([]store.Message, error) {
+	if count <= 0 {
+		return nil, ErrInvalidCount
 	}
-}
+	count = min(count, maxHistoryPageSize)
 
-func (c *Service) SubscribeNewMessages(ctx context.Context, channelID uuid.UUID) (*ChannelSubscription, error) {
-	sub := c.msgBroker.Sub(channelID)
-
-	return &ChannelSubscription{
-		ChannelID: channelID,
-		Msgs:      sub,
-		msgBroker: c.msgBroker,
-	}, nil
-}
-
-func (c *Service) GetMessagesHistory(ctx context.Context, serverID uuid.UUID, channelID uuid.UUID, from time.Time, count int) ([]store.Message, error) {
 	var messages []store.Message
 	err := c.db.NewSelect().
 		Model(&messages).
 		// Where("server_id = ?", serverID).
 		Where("channel_id = ?", channelID).
+		Where("parent_id IS NULL").
 		Where("timestamp < ?", from).
-		Order("timestamp DESC").
+		Order("timestamp DESC", "id DESC").
 		Relation("Attachments").
+		Relation("Previews").
 		Limit(count).
 		Scan(ctx)
 
@@ -54,17 +50,19 @@ func (c *Service) GetMessagesHistory(ctx context.Context, serverID uuid.UUID, ch
 	return messages, nil
 }
 
+// GetMessage returns a message of the channel, sql.ErrNoRows when the message is in another channel
 func (c *Service) GetMessage(ctx context.Context, serverID, channelID, messageID uuid.UUID) (store.Message, error) {
 	var message store.Message
 	err := c.db.NewSelect().
 		Model(&message).
-		// Where("server_id = ?", serverID).
-		// Where("channel_id = ?", channelID).
+		Where("channel_id = ?", channelID).
 		Where("id = ?", messageID).
 		Relation("Attachments").
-		Order("timestamp DESC").
+		Relation("Previews").
 		Scan(ctx)
-
+	if errors.Is(err, sql.ErrNoRows) {
+		return message, err
+	}
 	if err != nil {
 		c.log.Error("failed to get message", "server_id", serverID, "channel_id", channelID, "message_id", messageID, "error", err)
 		return message, err
@@ -72,49 +70,55 @@ func (c *Service) GetMessage(ctx context.Context, serverID, channelID, messageID
 	return message, nil
 }
 
-func (c *Service) SendMessage(ctx context.Context, senderID, serverID, channelID uuid.UUID, content string) (uuid.UUID, error) {
-	log := c.log.With("server_id", senderID, "server_id", serverID, "channel_id", channelID)
-
-	msg := store.Message{
-		ID:        uuid.New(),
-		Timestamp: time.Now(),
-		ChannelID: channelID,
-		SenderID:  senderID,
-		Content:   content,
+// getMessagesByIDs loads the messages with their attachments, keyed by ID
+func (c *Service) getMessagesByIDs(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]store.Message, error) {
+	byID := make(map[uuid.UUID]store.Message, len(ids))
+	if len(ids) == 0 {
+		return byID, nil
 	}
 
-	_, err := c.db.NewInsert().Model(&msg).Exec(ctx)
+	var messages []store.Message
+	err := c.db.NewSelect().
+		Model(&messages).
+		Where("id IN (?)", bun.In(ids)).
+		Relation("Attachments").
+		Relation("Previews").
+		Scan(ctx)
 	if err != nil {
-		log.Error("failed to send message", "message_id", msg.ID, "error", err)
-		return uuid.Nil, err
+		c.log.Error("failed to get messages by IDs", "message_ids", ids, "error", err)
+		return nil, err
 	}
 
-	c.msgBroker.Pub(msg.ID, channelID)
-
-	return msg.ID, err
-
-	// sql, args, err := sq.Insert("channel").
-	// 	Columns("id", "server_id", "channel_id", "sender_id", "content").
-	// 	Values(id, serverID, channelID, senderID, content).
-	// 	Suffix("RETURNING id").
-	// 	ToSql()
-	// if err != nil {
-	// 	return uuid.Nil, err
-	// }
+	for _, msg := range messages {
+		byID[msg.ID] = msg
+	}
 
-	// id, err = store.PgxExecInsertReturningID(ctx, c.dbpool, sql, args)
-	// if err != nil {
-	// 	return uuid.Nil, err
-	// }
+	return byID, nil
+}
 
-	// c.msgBroker.Pub(id, channelID)
+func (c *Service) SendMessage(ctx context.Context, senderID, serverID, channelID, replyToID uuid.UUID, content string) (uuid.UUID, error) {
+	return c.SendMessageWithAttachments(ctx, senderID, serverID, channelID, replyToID, content, nil, nil, 0)
+}
 
-	// return id, nil
+// SendMessageWithAttachments creates a new message with the specified attachments.
+// If replyToID is set, the message is posted to the thread of that message instead of the channel.
+// If ttl is set, the message is deleted once it passes, in addition to the TTL of the channel.
+func (c *Service) SendMessageWithAttachments(ctx context.Context, senderID, serverID, channelID, replyToID uuid.UUID, content string, attachmentIDs []uuid.UUID, attachmentNames []string, ttl time.Duration) (uuid.UUID, error) {
+	return c.sendMessage(ctx, uuid.New(), senderID, serverID, channelID, replyToID, content, attachmentIDs, attachmentNames, ttl)
 }
 
-// SendMessageWithAttachments creates a new message with the specified attachments
-func (c *Service) SendMessageWithAttachments(ctx context.Context, senderID, serverID, channelID uuid.UUID, content string, attachmentIDs []uuid.UUID, attachmentNames []string) (uuid.UUID, error) {
-	log := c.log.With("server_id", senderID, "server_id", serverID, "channel_id", channelID, "attachment_ids", attachmentIDs, "attachment_names", attachmentNames)
+// sendMessage creates the message with the given ID, inserting an ID that already exists fails.
+// The sender needs the permission to send messages in the channel.
+func (c *Service) sendMessage(ctx context.Context, msgID, senderID, serverID, channelID, replyToID uuid.UUID, content string, attachmentIDs []uuid.UUID, attachmentNames []string, ttl time.Duration) (uuid.UUID, error) {
+	if ttl < 0 {
+		return uuid.Nil, ErrInvalidTTL
+	}
+	// Checked here rather than only by the API, so scheduled messages of users who were removed or muted aren't posted
+	if err := c.CheckPermission(ctx, senderID, uuid.Nil, channelID, PermissionSendMessages); err != nil {
+		return uuid.Nil, err
+	}
+
+	log := c.log.With("sender_id", senderID, "server_id", serverID, "channel_id", channelID, "reply_to_id", replyToID, "attachment_ids", attachmentIDs, "attachment_names", attachmentNames)
 
 	// Create transaction
 	tx, err := c.db.BeginTx(ctx, nil)
@@ -125,13 +129,25 @@ func (c *Service) SendMessageWithAttachments(ctx context.Context, senderID, serv
 	defer tx.Rollback()
 
 	// Create the message
-	msgID := uuid.New()
 	msg := store.Message{
-		ID:        msgID,
-		Timestamp: time.Now(),
-		ChannelID: channelID,
-		SenderID:  senderID,
-		Content:   content,
+		ID:           msgID,
+		Timestamp:    time.Now(),
+		ChannelID:    channelID,
+		SenderID:     senderID,
+		Content:      content,
+		ContentNodes: markdown.Parse(content),
+	}
+	if ttl > 0 {
+		expiresAt := msg.Timestamp.Add(ttl)
+		msg.ExpiresAt = &expiresAt
+	}
+
+	if replyToID != uuid.Nil {
+		if err := c.addThreadReply(ctx, tx, channelID, replyToID); err != nil {
+			log.Error("failed to add reply to thread", "error", err)
+			return uuid.Nil, err
+		}
+		msg.ParentID = &replyToID
 	}
 
 	// Insert the message
@@ -140,7 +156,14 @@ func (c *Service) SendMessageWithAttachments(ctx context.Context, senderID, serv
 		return uuid.Nil, err
 	}
 
+	mentions, err := c.createMentions(ctx, tx, serverID, msg)
+	if err != nil {
+		log.Error("failed to create mentions", "error", err)
+		return uuid.Nil, err
+	}
+
 	// Add attachments if any
+	var attachments []store.MessageAttachment
 	if len(attachmentIDs) > 0 {
 		// Ensure we have the same number of names as IDs
 		if len(attachmentIDs) != len(attachmentNames) {
@@ -149,7 +172,7 @@ func (c *Service) SendMessageWithAttachments(ctx context.Context, senderID, serv
 		}
 
 		// Create attachment records
-		attachments := make([]store.MessageAttachment, len(attachmentIDs))
+		attachments = make([]store.MessageAttachment, len(attachmentIDs))
 		for i := range attachmentIDs {
 			attachments[i] = store.MessageAttachment{
 				ID:           uuid.New(),
@@ -174,7 +197,252 @@ func (c *Service) SendMessageWithAttachments(ctx context.Context, senderID, serv
 	}
 
 	// Publish message to subscribers
-	c.msgBroker.Pub(msgID, channelID)
+	msg.Attachments = attachments
+	c.clearTyping(senderID, channelID)
+	c.publishMessageCreated(msg)
+	c.publishMentions(serverID, msg, mentions)
+
+	if replyToID != uuid.Nil {
+		c.publishThreadUpdated(ctx, serverID, channelID, replyToID)
+	}
+
+	c.unfurlMessage(msg)
 
 	return msgID, nil
 }
+
+// EditMessage replaces the content of a message sent by editorID, keeping the previous content as a revision
+func (c *Service) EditMessage(ctx context.Context, editorID, serverID, channelID, messageID uuid.UUID, content string) (store.Message, error) {
+	log := c.log.With("editor_id", editorID, "server_id", serverID, "channel_id", channelID, "message_id", messageID)
+
+	var msg store.Message
+	err := c.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		err := tx.NewSelect().
+			Model(&msg).
+			Where("id = ?", messageID).
+			Where("channel_id = ?", channelID).
+			For("UPDATE").
+			Scan(ctx)
+		if err != nil {
+			return err
+		}
+
+		if msg.DeletedAt != nil {
+			return ErrMessageDeleted
+		}
+		if msg.SenderID != editorID {
+			return ErrNotMessageAuthor
+		}
+		if msg.SystemEvent != nil {
+			return ErrSystemMessage
+		}
+
+		revision := store.MessageRevision{
+			ID:        uuid.New(),
+			MessageID: msg.ID,
+			Content:   msg.Content,
+			Timestamp: time.Now(),
+		}
+		_, err = tx.NewInsert().Model(&revision).Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		editedAt := time.Now()
+		msg.Content = content
+		msg.ContentNodes = markdown.Parse(content)
+		msg.EditedAt = &editedAt
+
+		_, err = tx.NewUpdate().
+			Model(&msg).
+			Column("content", "content_nodes", "edited_at").
+			WherePK().
+			Exec(ctx)
+		return err
+	})
+	if err != nil {
+		log.Error("failed to edit message", "error", err)
+		return msg, err
+	}
+
+	// Reload the message so the caller gets the attachments too
+	msg, err = c.GetMessage(ctx, serverID, channelID, messageID)
+	if err != nil {
+		return msg, err
+	}
+
+	// The event is shared by every subscriber, so reactions are loaded without a viewer
+	msgs := []store.Message{msg}
+	if err := c.LoadReactions(ctx, uuid.Nil, msgs); err != nil {
+		return msg, err
+	}
+	msg = msgs[0]
+
+	c.publishMessageEdited(msg)
+	c.unfurlMessage(msg)
+
+	return msg, nil
+}
+
+// ListMessageRevisions returns the previous versions of a message, oldest first.
+// Only the author of the message or a moderator can see them.
+func (c *Service) ListMessageRevisions(ctx context.Context, userID, serverID, channelID, messageID uuid.UUID) ([]store.MessageRevision, error) {
+	log := c.log.With("user_id", userID, "server_id", serverID, "channel_id", channelID, "message_id", messageID)
+
+	var msg store.Message
+	err := c.db.NewSelect().
+		Model(&msg).
+		Column("sender_id").
+		Where("id = ?", messageID).
+		Where("channel_id = ?", channelID).
+		Scan(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+	if err != nil {
+		log.Error("failed to get message", "error", err)
+		return nil, err
+	}
+	if msg.SenderID != userID && !c.hasPermission(ctx, userID, serverID, channelID, PermissionManageMessages) {
+		return nil, ErrNotMessageAuthor
+	}
+
+	var revisions []store.MessageRevision
+	err = c.db.NewSelect().
+		Model(&revisions).
+		Where("message_id = ?", messageID).
+		Order("timestamp ASC").
+		Scan(ctx)
+	if err != nil {
+		log.Error("failed to list message revisions", "error", err)
+		return nil, err
+	}
+
+	return revisions, nil
+}
+
+// DeleteMessage turns a message into a tombstone and removes its attachments.
+// Only the author of the message or a moderator can delete it.
+func (c *Service) DeleteMessage(ctx context.Context, deleterID, serverID, channelID, messageID uuid.UUID) error {
+	log := c.log.With("deleter_id", deleterID, "server_id", serverID, "channel_id", channelID, "message_id", messageID)
+
+	var msg store.Message
+	var attachments []store.MessageAttachment
+	var previews []store.LinkPreview
+	err := c.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		err := tx.NewSelect().
+			Model(&msg).
+			Where("id = ?", messageID).
+			Where("channel_id = ?", channelID).
+			For("UPDATE").
+			Scan(ctx)
+		if err != nil {
+			return err
+		}
+
+		if msg.DeletedAt != nil {
+			return ErrMessageDeleted
+		}
+		if msg.SenderID != deleterID && !c.hasPermission(ctx, deleterID, serverID, channelID, PermissionManageMessages) {
+			return ErrNotMessageAuthor
+		}
+
+		_, err = tx.NewDelete().
+			Model(&attachments).
+			Where("message_id = ?", messageID).
+			Returning("*").
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.NewDelete().
+			Model(&previews).
+			Where("message_id = ?", messageID).
+			Returning("*").
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.NewDelete().
+			Model((*store.MessageRevision)(nil)).
+			Where("message_id = ?", messageID).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.NewDelete().
+			Model((*store.MessageReaction)(nil)).
+			Where("message_id = ?", messageID).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.NewDelete().
+			Model((*store.PinnedMessage)(nil)).
+			Where("message_id = ?", messageID).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		deletedAt := time.Now()
+		msg.Content = ""
+		msg.ContentNodes = nil
+		msg.DeletedAt = &deletedAt
+
+		_, err = tx.NewUpdate().
+			Model(&msg).
+			Column("content", "content_nodes", "deleted_at").
+			WherePK().
+			Exec(ctx)
+		return err
+	})
+	if err != nil {
+		log.Error("failed to delete message", "error", err)
+		return err
+	}
+
+	c.publishMessageDeleted(msg)
+
+	c.deleteUnreferencedAttachments(ctx, attachments)
+	c.deleteThumbnails(ctx, previews)
+
+	return nil
+}
+
+// deleteUnreferencedAttachments removes blobs from the attachment storage
+// when no message references them anymore
+func (c *Service) deleteUnreferencedAttachments(ctx context.Context, attachments []store.MessageAttachment) {
+	if len(attachments) == 0 {
+		return
+	}
+
+	ids := make([]uuid.UUID, 0, len(attachments))
+	for _, a := range attachments {
+		ids = append(ids, a.AttachmentID)
+	}
+
+	var referenced []uuid.UUID
+	err := c.db.NewSelect().
+		Model((*store.MessageAttachment)(nil)).
+		Column("attachment_id").
+		Where("attachment_id IN (?)", bun.In(ids)).
+		Scan(ctx, &referenced)
+	if err != nil {
+		c.log.Error("failed to check attachment references", "attachment_ids", ids, "error", err)
+		return
+	}
+
+	for _, id := range ids {
+		if slices.Contains(referenced, id) {
+			continue
+		}
+		if err := c.attachStorage.Delete(ctx, id); err != nil {
+			c.log.Error("failed to delete attachment blob", "attachment_id", id, "error", err)
+		}
+	}
+}
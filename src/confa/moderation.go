@@ -0,0 +1,498 @@
+package confa
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/confa-chat/node/pkg/uuid"
+	"github.com/confa-chat/node/src/store"
+	"github.com/uptrace/bun"
+)
+
+var (
+	// ErrPermissionDenied is returned when the user lacks the rights for an action
+	ErrPermissionDenied = errors.New("permission denied")
+	// ErrBanned is returned when a banned user tries to join the server
+	ErrBanned = errors.New("user is banned from the server")
+	// ErrMuted is returned when a muted member tries to send messages or react on the server
+	ErrMuted = errors.New("user is muted on the server")
+	// ErrRemovedFromServer ends the subscriptions of a member who left, was kicked or banned, or whose server was deleted
+	ErrRemovedFromServer = errors.New("user was removed from the server")
+	// ErrInvalidSanction is returned for a negative ban duration, a mute without a duration or a too long reason
+	ErrInvalidSanction = errors.New("invalid reason or duration")
+)
+
+const (
+	// maxSanctionReasonLength is the maximum length of the reason of a ban or mute in characters
+	maxSanctionReasonLength = 512
+	// mutedPermissions are taken away from muted members
+	mutedPermissions = PermissionSendMessages | PermissionAddReactions
+)
+
+// IsModerator reports whether the user is allowed to moderate content on the server
+func (c *Service) IsModerator(ctx context.Context, serverID, userID uuid.UUID) bool {
+	return c.hasPermission(ctx, userID, serverID, uuid.Nil, PermissionManageMessages)
+}
+
+// isNodeModerator reports whether the user is listed as a moderator of every server in the config
+func (c *Service) isNodeModerator(userID uuid.UUID) bool {
+	return slices.Contains(c.Config.Moderators, userID.String())
+}
+
+// checkModeration returns ErrPermissionDenied unless the user has perm on the server and outranks the target:
+// nobody can act on themselves or the owner, and unless the user is the owner, they must have every permission
+// of the target and at least one more, so administrators can't act on each other
+func (c *Service) checkModeration(ctx context.Context, userID, serverID, targetID uuid.UUID, perm Permission) error {
+	if userID == targetID {
+		return ErrPermissionDenied
+	}
+
+	actor, err := c.serverPermissions(ctx, userID, serverID)
+	if err != nil {
+		return err
+	}
+	if !actor.base.Has(perm) {
+		return ErrPermissionDenied
+	}
+
+	var server store.Server
+	err = c.db.NewSelect().
+		Model(&server).
+		Where("id = ?", serverID).
+		Scan(ctx)
+	if err != nil {
+		return err
+	}
+	if server.OwnerID != nil {
+		if *server.OwnerID == targetID {
+			return ErrPermissionDenied
+		}
+		if *server.OwnerID == userID {
+			return nil
+		}
+	}
+
+	target, err := c.serverPermissions(ctx, targetID, serverID)
+	if err != nil {
+		return err
+	}
+	if !outranks(actor.base, target.base) {
+		return ErrPermissionDenied
+	}
+	return nil
+}
+
+// outranks reports whether actor has every permission of target and at least one more
+func outranks(actor, target Permission) bool {
+	return actor&target == target && actor != target
+}
+
+// validSanctionReason trims the reason and reports whether it is short enough
+func validSanctionReason(reason string) (string, bool) {
+	reason = strings.TrimSpace(reason)
+	return reason, utf8.RuneCountInString(reason) <= maxSanctionReasonLength
+}
+
+// KickMember removes the member from the server, ends their subscriptions and drops their scheduled messages.
+// They can join again with an invite.
+func (c *Service) KickMember(ctx context.Context, userID, serverID, memberID uuid.UUID) error {
+	log := c.log.With("user_id", userID, "server_id", serverID, "member_id", memberID)
+
+	if err := c.checkModeration(ctx, userID, serverID, memberID, PermissionKickMembers); err != nil {
+		if !errors.Is(err, ErrPermissionDenied) {
+			log.Error("failed to check moderation permissions", "error", err)
+		}
+		return err
+	}
+
+	err := c.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		res, err := tx.NewDelete().
+			Model((*store.ServerMember)(nil)).
+			Where("server_id = ?", serverID).
+			Where("user_id = ?", memberID).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		if deleted, _ := res.RowsAffected(); deleted == 0 {
+			return ErrNotServerMember
+		}
+		return deleteScheduledMessages(ctx, tx, serverID, memberID)
+	})
+	if errors.Is(err, ErrNotServerMember) {
+		return err
+	}
+	if err != nil {
+		log.Error("failed to kick member", "error", err)
+		return err
+	}
+
+	c.members.evict(serverID, memberID)
+	log.Info("member kicked")
+
+	return nil
+}
+
+// BanMember removes the user from the server like KickMember and keeps them from joining it again.
+// A ban without a duration lasts until it is lifted, banning a banned user replaces the ban.
+// Users who aren't members can be banned too.
+func (c *Service) BanMember(ctx context.Context, userID, serverID, targetID uuid.UUID, reason string, duration time.Duration) (store.ServerBan, error) {
+	log := c.log.With("user_id", userID, "server_id", serverID, "target_id", targetID, "duration", duration)
+
+	reason, ok := validSanctionReason(reason)
+	if !ok || duration < 0 {
+		return store.ServerBan{}, ErrInvalidSanction
+	}
+	if err := c.checkModeration(ctx, userID, serverID, targetID, PermissionBanMembers); err != nil {
+		if !errors.Is(err, ErrPermissionDenied) {
+			log.Error("failed to check moderation permissions", "error", err)
+		}
+		return store.ServerBan{}, err
+	}
+
+	now := time.Now()
+	ban := store.ServerBan{
+		ServerID:    serverID,
+		UserID:      targetID,
+		ModeratorID: &userID,
+		Reason:      reason,
+		CreatedAt:   now,
+	}
+	if duration > 0 {
+		expiresAt := now.Add(duration)
+		ban.ExpiresAt = &expiresAt
+	}
+
+	err := c.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		exists, err := tx.NewSelect().
+			Model((*store.User)(nil)).
+			Where("id = ?", targetID).
+			Exists(ctx)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return sql.ErrNoRows
+		}
+
+		_, err = tx.NewInsert().
+			Model(&ban).
+			On("CONFLICT (server_id, user_id) DO UPDATE").
+			Set("moderator_id = EXCLUDED.moderator_id").
+			Set("reason = EXCLUDED.reason").
+			Set("expires_at = EXCLUDED.expires_at").
+			Set("created_at = EXCLUDED.created_at").
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.NewDelete().
+			Model((*store.ServerMember)(nil)).
+			Where("server_id = ?", serverID).
+			Where("user_id = ?", targetID).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		return deleteScheduledMessages(ctx, tx, serverID, targetID)
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return ban, err
+	}
+	if err != nil {
+		log.Error("failed to ban member", "error", err)
+		return ban, err
+	}
+
+	c.members.evict(serverID, targetID)
+	log.Info("member banned")
+
+	return ban, nil
+}
+
+// UnbanMember lifts the ban of the user, they can join the server again with an invite
+func (c *Service) UnbanMember(ctx context.Context, userID, serverID, targetID uuid.UUID) error {
+	log := c.log.With("user_id", userID, "server_id", serverID, "target_id", targetID)
+
+	if err := c.CheckPermission(ctx, userID, serverID, uuid.Nil, PermissionBanMembers); err != nil {
+		return err
+	}
+
+	res, err := c.db.NewDelete().
+		Model((*store.ServerBan)(nil)).
+		Where("server_id = ?", serverID).
+		Where("user_id = ?", targetID).
+		Where("expires_at IS NULL OR expires_at > now()").
+		Exec(ctx)
+	if err != nil {
+		log.Error("failed to unban member", "error", err)
+		return err
+	}
+	if deleted, _ := res.RowsAffected(); deleted == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// ListBans returns the bans of the server which didn't expire yet, the newest first
+func (c *Service) ListBans(ctx context.Context, userID, serverID uuid.UUID) ([]store.ServerBan, error) {
+	if err := c.CheckPermission(ctx, userID, serverID, uuid.Nil, PermissionBanMembers); err != nil {
+		return nil, err
+	}
+
+	var bans []store.ServerBan
+	err := c.db.NewSelect().
+		Model(&bans).
+		Where("server_id = ?", serverID).
+		Where("expires_at IS NULL OR expires_at > now()").
+		Order("created_at DESC").
+		Scan(ctx)
+	if err != nil {
+		c.log.Error("failed to list bans", "server_id", serverID, "error", err)
+		return nil, err
+	}
+
+	return bans, nil
+}
+
+// isBanned reports whether the user has a ban on the server which didn't expire yet
+func isBanned(ctx context.Context, db bun.IDB, serverID, userID uuid.UUID) (bool, error) {
+	return db.NewSelect().
+		Model((*store.ServerBan)(nil)).
+		Where("server_id = ?", serverID).
+		Where("user_id = ?", userID).
+		Where("expires_at IS NULL OR expires_at > now()").
+		Exists(ctx)
+}
+
+// MuteMember stops the member from sending messages and reacting on the server for the duration,
+// muting a muted member replaces the mute. The mute is kept when the member leaves the server.
+func (c *Service) MuteMember(ctx context.Context, userID, serverID, memberID uuid.UUID, reason string, duration time.Duration) (store.ServerMute, error) {
+	log := c.log.With("user_id", userID, "server_id", serverID, "member_id", memberID, "duration", duration)
+
+	reason, ok := validSanctionReason(reason)
+	if !ok || duration <= 0 {
+		return store.ServerMute{}, ErrInvalidSanction
+	}
+	if err := c.checkModeration(ctx, userID, serverID, memberID, PermissionMuteMembers); err != nil {
+		if !errors.Is(err, ErrPermissionDenied) {
+			log.Error("failed to check moderation permissions", "error", err)
+		}
+		return store.ServerMute{}, err
+	}
+
+	now := time.Now()
+	mute := store.ServerMute{
+		ServerID:    serverID,
+		UserID:      memberID,
+		ModeratorID: &userID,
+		Reason:      reason,
+		ExpiresAt:   now.Add(duration),
+		CreatedAt:   now,
+	}
+
+	err := c.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		isMember, err := tx.NewSelect().
+			Model((*store.ServerMember)(nil)).
+			Where("server_id = ?", serverID).
+			Where("user_id = ?", memberID).
+			Exists(ctx)
+		if err != nil {
+			return err
+		}
+		if !isMember {
+			return ErrNotServerMember
+		}
+
+		_, err = tx.NewInsert().
+			Model(&mute).
+			On("CONFLICT (server_id, user_id) DO UPDATE").
+			Set("moderator_id = EXCLUDED.moderator_id").
+			Set("reason = EXCLUDED.reason").
+			Set("expires_at = EXCLUDED.expires_at").
+			Set("created_at = EXCLUDED.created_at").
+			Exec(ctx)
+		return err
+	})
+	if errors.Is(err, ErrNotServerMember) {
+		return mute, err
+	}
+	if err != nil {
+		log.Error("failed to mute member", "error", err)
+		return mute, err
+	}
+
+	return mute, nil
+}
+
+// UnmuteMember lifts the mute of the member before it expires
+func (c *Service) UnmuteMember(ctx context.Context, userID, serverID, memberID uuid.UUID) error {
+	log := c.log.With("user_id", userID, "server_id", serverID, "member_id", memberID)
+
+	if err := c.CheckPermission(ctx, userID, serverID, uuid.Nil, PermissionMuteMembers); err != nil {
+		return err
+	}
+
+	res, err := c.db.NewDelete().
+		Model((*store.ServerMute)(nil)).
+		Where("server_id = ?", serverID).
+		Where("user_id = ?", memberID).
+		Where("expires_at > now()").
+		Exec(ctx)
+	if err != nil {
+		log.Error("failed to unmute member", "error", err)
+		return err
+	}
+	if deleted, _ := res.RowsAffected(); deleted == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// deleteScheduledMessages drops the messages the user scheduled in the channels of the server
+func deleteScheduledMessages(ctx context.Context, db bun.IDB, serverID, userID uuid.UUID) error {
+	_, err := db.NewDelete().
+		Model((*store.ScheduledMessage)(nil)).
+		Where("sender_id = ?", userID).
+		Where("channel_id IN (SELECT id FROM text_channel WHERE server_id = ?)", serverID).
+		Exec(ctx)
+	return err
+}
+
+// deleteExpiredSanctions removes bans and mutes which are over, they are ignored once expired
+// so this only keeps the tables small
+func (c *Service) deleteExpiredSanctions(ctx context.Context) {
+	_, err := c.db.NewDelete().
+		Model((*store.ServerBan)(nil)).
+		Where("expires_at <= now()").
+		Exec(ctx)
+	if err != nil {
+		c.log.Error("failed to delete expired bans", "error", err)
+	}
+
+	_, err = c.db.NewDelete().
+		Model((*store.ServerMute)(nil)).
+		Where("expires_at <= now()").
+		Exec(ctx)
+	if err != nil {
+		c.log.Error("failed to delete expired mutes", "error", err)
+	}
+}
+
+// memberWatch is a subscription of a user to a channel
+type memberWatch struct {
+	userID    uuid.UUID
+	channelID uuid.UUID
+	cancel    context.CancelCauseFunc
+}
+
+// memberWatches ends the subscriptions of users who lose access to a channel.
+// Watches are keyed by the server of the channel, or by the channel itself for direct and group channels.
+type memberWatches struct {
+	mu      sync.Mutex
+	next    uint64
+	watches map[uuid.UUID]map[uint64]memberWatch
+}
+
+func newMemberWatches() *memberWatches {
+	return &memberWatches{
+		watches: map[uuid.UUID]map[uint64]memberWatch{},
+	}
+}
+
+// watch returns a context which is cancelled when the user loses access to the channel of the server, or of scopeID:
+// with ErrRemovedFromServer when they are removed from it, with ErrPermissionDenied when they can't view the channel anymore.
+// release cancels the context and must be called once it isn't needed anymore.
+func (w *memberWatches) watch(ctx context.Context, scopeID, userID, channelID uuid.UUID) (context.Context, func()) {
+	ctx, cancel := context.WithCancelCause(ctx)
+
+	w.mu.Lock()
+	id := w.next
+	w.next++
+	if w.watches[scopeID] == nil {
+		w.watches[scopeID] = map[uint64]memberWatch{}
+	}
+	w.watches[scopeID][id] = memberWatch{userID: userID, channelID: channelID, cancel: cancel}
+	w.mu.Unlock()
+
+	release := func() {
+		w.mu.Lock()
+		delete(w.watches[scopeID], id)
+		if len(w.watches[scopeID]) == 0 {
+			delete(w.watches, scopeID)
+		}
+		w.mu.Unlock()
+		cancel(context.Canceled)
+	}
+	return ctx, release
+}
+
+// evict cancels the watches of the user on the server with ErrRemovedFromServer, of every user when userID is nil
+func (w *memberWatches) evict(serverID, userID uuid.UUID) {
+	w.cancelWhere(serverID, ErrRemovedFromServer, func(watch memberWatch) bool {
+		return userID == uuid.Nil || watch.userID == userID
+	})
+}
+
+// list returns the watches of the server
+func (w *memberWatches) list(serverID uuid.UUID) []memberWatch {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	watches := make([]memberWatch, 0, len(w.watches[serverID]))
+	for _, watch := range w.watches[serverID] {
+		watches = append(watches, watch)
+	}
+	return watches
+}
+
+// cancelWhere cancels the watches of the server, or of the channel outside of servers, matching the filter with the cause
+func (w *memberWatches) cancelWhere(serverID uuid.UUID, cause error, filter func(memberWatch) bool) {
+	var cancels []context.CancelCauseFunc
+
+	w.mu.Lock()
+	for id, watch := range w.watches[serverID] {
+		if filter(watch) {
+			cancels = append(cancels, watch.cancel)
+			delete(w.watches[serverID], id)
+		}
+	}
+	if len(w.watches[serverID]) == 0 {
+		delete(w.watches, serverID)
+	}
+	w.mu.Unlock()
+
+	for _, cancel := range cancels {
+		cancel(cause)
+	}
+}
+
+// recheckSubscriptions ends the subscriptions to channels of the server which their user can't view anymore,
+// it is called after the roles or the channel overwrites of the server changed
+func (c *Service) recheckSubscriptions(ctx context.Context, serverID uuid.UUID) {
+	byChannel := map[uuid.UUID][]uuid.UUID{}
+	for _, watch := range c.members.list(serverID) {
+		byChannel[watch.channelID] = append(byChannel[watch.channelID], watch.userID)
+	}
+
+	for channelID, userIDs := range byChannel {
+		viewers, err := c.channelViewers(ctx, c.db, channelID, userIDs)
+		if err != nil {
+			c.log.Error("failed to recheck channel subscriptions", "server_id", serverID, "channel_id", channelID, "error", err)
+			continue
+		}
+
+		c.members.cancelWhere(serverID, ErrPermissionDenied, func(watch memberWatch) bool {
+			return watch.channelID == channelID && !slices.Contains(viewers, watch.userID)
+		})
+	}
+}
@@ -0,0 +1,415 @@
+package confa
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"slices"
+
+	"github.com/confa-chat/node/pkg/uuid"
+	"github.com/confa-chat/node/src/store"
+	"github.com/uptrace/bun"
+)
+
+// Permission is a set of actions allowed on a server or in a channel
+type Permission uint64
+
+const (
+	// PermissionViewChannel allows reading a channel, a channel without it is hidden
+	PermissionViewChannel Permission = 1 << iota
+	PermissionSendMessages
+	PermissionAddReactions
+	PermissionCreateInvites
+	// PermissionManageMessages allows deleting messages of other users and pinning messages
+	PermissionManageMessages
+	// PermissionManageChannels allows creating and editing channels
+	PermissionManageChannels
+	// PermissionManageRoles allows editing roles, assigning them and overwriting permissions in channels
+	PermissionManageRoles
+	// PermissionManageServer allows renaming the server, exporting channels and revoking any invite
+	PermissionManageServer
+	PermissionKickMembers
+	PermissionBanMembers
+	PermissionMuteMembers
+	// PermissionAdministrator grants every permission and can't be denied in channels
+	PermissionAdministrator
+
+	// PermissionAll is every permission, given to the owner of a server
+	PermissionAll = PermissionAdministrator<<1 - 1
+)
+
+const (
+	// DefaultPermissions are given to the @everyone role of new servers
+	DefaultPermissions = PermissionViewChannel | PermissionSendMessages | PermissionAddReactions | PermissionCreateInvites
+	// directPermissions are given to the members of direct and group channels
+	directPermissions = PermissionViewChannel | PermissionSendMessages | PermissionAddReactions
+	// nodeModeratorPermissions are added to the roles of the node moderators who joined a server
+	nodeModeratorPermissions = PermissionViewChannel | PermissionManageMessages
+)
+
+// Has reports whether all the permissions of perm are set, administrators have every permission
+func (p Permission) Has(perm Permission) bool {
+	return p&PermissionAdministrator != 0 || p&perm == perm
+}
+
+// Permissions resolves what the user is allowed to do in the channel, or on the server when channelID is nil.
+// The server of a channel is looked up from the channel, serverID is ignored when channelID is set.
+//
+// Server permissions are the union of the @everyone role and the roles of the member, the owner of the server
+// has every permission and the node moderators who joined it can also moderate messages. In a channel the overwrites of @everyone, then of the roles
+// of the member and finally of the member itself are applied. Users who aren't members have no permission,
+// muted members can't send messages or react.
+func (c *Service) Permissions(ctx context.Context, userID, serverID, channelID uuid.UUID) (Permission, error) {
+	perms, _, err := c.resolvePermissions(ctx, userID, serverID, channelID)
+	return perms, err
+}
+
+// resolvePermissions returns the permissions of the user and whether the user is muted, see Permissions
+func (c *Service) resolvePermissions(ctx context.Context, userID, serverID, channelID uuid.UUID) (Permission, bool, error) {
+	log := c.log.With("user_id", userID, "server_id", serverID, "channel_id", channelID)
+
+	if channelID != uuid.Nil {
+		var channel store.TextChannel
+		err := c.db.NewSelect().
+			Model(&channel).
+			Column("id", "server_id").
+			Where("id = ?", channelID).
+			Scan(ctx)
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, false, nil
+		}
+		if err != nil {
+			log.Error("failed to get channel", "error", err)
+			return 0, false, err
+		}
+
+		if channel.ServerID == nil {
+			perms, err := c.directChannelPermissions(ctx, userID, channelID)
+			return perms, false, err
+		}
+		serverID = *channel.ServerID
+	}
+
+	member, err := c.serverPermissions(ctx, userID, serverID)
+	if err != nil {
+		log.Error("failed to resolve server permissions", "error", err)
+		return 0, false, err
+	}
+	if channelID == uuid.Nil || !member.isMember || member.base.Has(PermissionAdministrator) {
+		return member.restrict(member.base), member.muted, nil
+	}
+
+	var overwrites []store.ChannelOverwrite
+	err = c.db.NewSelect().
+		Model(&overwrites).
+		Where("channel_id = ?", channelID).
+		Scan(ctx)
+	if err != nil {
+		log.Error("failed to list channel overwrites", "error", err)
+		return 0, false, err
+	}
+
+	return member.restrict(member.inChannel(overwrites)), member.muted, nil
+}
+
+// CheckPermission returns ErrPermissionDenied when the user lacks perm in the channel,
+// or on the server when channelID is nil. See Permissions for how they are resolved.
+// ErrMuted is returned instead when perm was taken away by a mute.
+func (c *Service) CheckPermission(ctx context.Context, userID, serverID, channelID uuid.UUID, perm Permission) error {
+	perms, muted, err := c.resolvePermissions(ctx, userID, serverID, channelID)
+	if err != nil {
+		return err
+	}
+	if perms == 0 || !perms.Has(perm) {
+		if muted && perms != 0 && perm&mutedPermissions != 0 {
+			return ErrMuted
+		}
+		return ErrPermissionDenied
+	}
+	return nil
+}
+
+// hasPermission reports whether the user has perm, errors are logged and deny the permission
+func (c *Service) hasPermission(ctx context.Context, userID, serverID, channelID uuid.UUID, perm Permission) bool {
+	return c.CheckPermission(ctx, userID, serverID, channelID, perm) == nil
+}
+
+// VisibleChannels returns the channels of the server which the user can view
+func (c *Service) VisibleChannels(ctx context.Context, userID, serverID uuid.UUID, channels []store.TextChannel) ([]store.TextChannel, error) {
+	member, err := c.serverPermissions(ctx, userID, serverID)
+	if err != nil {
+		c.log.Error("failed to resolve server permissions", "user_id", userID, "server_id", serverID, "error", err)
+		return nil, err
+	}
+	if !member.isMember {
+		return nil, nil
+	}
+	if member.base.Has(PermissionAdministrator) || len(channels) == 0 {
+		return channels, nil
+	}
+
+	ids := make([]uuid.UUID, len(channels))
+	for i, channel := range channels {
+		ids[i] = channel.ID
+	}
+
+	var overwrites []store.ChannelOverwrite
+	err = c.db.NewSelect().
+		Model(&overwrites).
+		Where("channel_id IN (?)", bun.In(ids)).
+		Scan(ctx)
+	if err != nil {
+		c.log.Error("failed to list channel overwrites", "user_id", userID, "server_id", serverID, "error", err)
+		return nil, err
+	}
+
+	return slices.DeleteFunc(slices.Clone(channels), func(channel store.TextChannel) bool {
+		channelOverwrites := slices.DeleteFunc(slices.Clone(overwrites), func(o store.ChannelOverwrite) bool {
+			return o.ChannelID != channel.ID
+		})
+		return !member.inChannel(channelOverwrites).Has(PermissionViewChannel)
+	}), nil
+}
+
+// memberPermissions are the permissions of a user on a server before channel overwrites
+type memberPermissions struct {
+	userID   uuid.UUID
+	serverID uuid.UUID
+	isMember bool
+	muted    bool
+	base     Permission
+	// roleIDs are the roles of the member without the @everyone role
+	roleIDs []uuid.UUID
+}
+
+// serverPermissions resolves the permissions of the user on the server
+func (c *Service) serverPermissions(ctx context.Context, userID, serverID uuid.UUID) (memberPermissions, error) {
+	members, err := c.membersPermissions(ctx, c.db, serverID, []uuid.UUID{userID})
+	if err != nil {
+		return memberPermissions{userID: userID, serverID: serverID}, err
+	}
+	return members[0], nil
+}
+
+// membersPermissions resolves the permissions of the users on the server, in the order of userIDs
+func (c *Service) membersPermissions(ctx context.Context, db bun.IDB, serverID uuid.UUID, userIDs []uuid.UUID) ([]memberPermissions, error) {
+	members := make([]memberPermissions, len(userIDs))
+	for i, userID := range userIDs {
+		members[i] = memberPermissions{userID: userID, serverID: serverID}
+	}
+	if len(userIDs) == 0 {
+		return members, nil
+	}
+
+	var server store.Server
+	err := db.NewSelect().
+		Model(&server).
+		Where("id = ?", serverID).
+		Scan(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return members, nil
+	}
+	if err != nil {
+		return members, err
+	}
+
+	var memberIDs []uuid.UUID
+	err = db.NewSelect().
+		Model((*store.ServerMember)(nil)).
+		Column("user_id").
+		Where("server_id = ?", serverID).
+		Where("user_id IN (?)", bun.In(userIDs)).
+		Scan(ctx, &memberIDs)
+	if err != nil {
+		return members, err
+	}
+	if len(memberIDs) == 0 {
+		return members, nil
+	}
+
+	var mutedIDs []uuid.UUID
+	err = db.NewSelect().
+		Model((*store.ServerMute)(nil)).
+		Column("user_id").
+		Where("server_id = ?", serverID).
+		Where("user_id IN (?)", bun.In(memberIDs)).
+		Where("expires_at > now()").
+		Scan(ctx, &mutedIDs)
+	if err != nil {
+		return members, err
+	}
+
+	var roles []store.Role
+	err = db.NewSelect().
+		Model(&roles).
+		Where("server_id = ?", serverID).
+		Scan(ctx)
+	if err != nil {
+		return members, err
+	}
+
+	var assignments []store.MemberRole
+	err = db.NewSelect().
+		Model(&assignments).
+		Where("server_id = ?", serverID).
+		Where("user_id IN (?)", bun.In(memberIDs)).
+		Scan(ctx)
+	if err != nil {
+		return members, err
+	}
+
+	rolePerms := make(map[uuid.UUID]Permission, len(roles))
+	for _, role := range roles {
+		rolePerms[role.ID] = Permission(role.Permissions)
+	}
+	isMember := make(map[uuid.UUID]bool, len(memberIDs))
+	for _, id := range memberIDs {
+		isMember[id] = true
+	}
+
+	for i := range members {
+		member := &members[i]
+		if !isMember[member.userID] {
+			continue
+		}
+		member.isMember = true
+		member.muted = slices.Contains(mutedIDs, member.userID)
+
+		if server.OwnerID != nil && *server.OwnerID == member.userID {
+			member.base = PermissionAll
+			continue
+		}
+
+		member.base = rolePerms[serverID]
+		for _, assignment := range assignments {
+			if assignment.UserID == member.userID {
+				member.base |= rolePerms[assignment.RoleID]
+				member.roleIDs = append(member.roleIDs, assignment.RoleID)
+			}
+		}
+		if c.isNodeModerator(member.userID) {
+			member.base |= nodeModeratorPermissions
+		}
+		if member.base.Has(PermissionAdministrator) {
+			member.base = PermissionAll
+		}
+	}
+
+	return members, nil
+}
+
+// channelViewers returns the users among userIDs who can view the channel
+func (c *Service) channelViewers(ctx context.Context, db bun.IDB, channelID uuid.UUID, userIDs []uuid.UUID) ([]uuid.UUID, error) {
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+
+	var channel store.TextChannel
+	err := db.NewSelect().
+		Model(&channel).
+		Column("id", "server_id").
+		Where("id = ?", channelID).
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var viewers []uuid.UUID
+	if channel.ServerID == nil {
+		err := db.NewSelect().
+			Model((*store.ChannelMember)(nil)).
+			Column("user_id").
+			Where("channel_id = ?", channelID).
+			Where("user_id IN (?)", bun.In(userIDs)).
+			Scan(ctx, &viewers)
+		return viewers, err
+	}
+
+	members, err := c.membersPermissions(ctx, db, *channel.ServerID, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	var overwrites []store.ChannelOverwrite
+	err = db.NewSelect().
+		Model(&overwrites).
+		Where("channel_id = ?", channelID).
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, member := range members {
+		if member.inChannel(overwrites).Has(PermissionViewChannel) {
+			viewers = append(viewers, member.userID)
+		}
+	}
+	return viewers, nil
+}
+
+// directChannelPermissions returns the permissions of the user in a direct or group channel
+func (c *Service) directChannelPermissions(ctx context.Context, userID, channelID uuid.UUID) (Permission, error) {
+	isMember, err := c.db.NewSelect().
+		Model((*store.ChannelMember)(nil)).
+		Where("channel_id = ?", channelID).
+		Where("user_id = ?", userID).
+		Exists(ctx)
+	if err != nil {
+		c.log.Error("failed to check channel membership", "user_id", userID, "channel_id", channelID, "error", err)
+		return 0, err
+	}
+	if !isMember {
+		return 0, nil
+	}
+	return directPermissions, nil
+}
+
+// inChannel applies the overwrites of a channel to the permissions of the member.
+// The @everyone overwrite comes first, then the overwrites of all the roles of the member together
+// and the overwrite of the member last. Without the view permission the member has no permission in the channel.
+func (m memberPermissions) inChannel(overwrites []store.ChannelOverwrite) Permission {
+	if !m.isMember {
+		return 0
+	}
+	if m.base.Has(PermissionAdministrator) {
+		return m.base
+	}
+
+	perms := m.base
+	for _, o := range overwrites {
+		if o.TargetType == store.OverwriteTargetRole && o.TargetID == m.serverID {
+			perms = perms&^Permission(o.Deny) | Permission(o.Allow)
+		}
+	}
+
+	var allow, deny Permission
+	for _, o := range overwrites {
+		if o.TargetType == store.OverwriteTargetRole && slices.Contains(m.roleIDs, o.TargetID) {
+			allow |= Permission(o.Allow)
+			deny |= Permission(o.Deny)
+		}
+	}
+	perms = perms&^deny | allow
+
+	for _, o := range overwrites {
+		if o.TargetType == store.OverwriteTargetMember && o.TargetID == m.userID {
+			perms = perms&^Permission(o.Deny) | Permission(o.Allow)
+		}
+	}
+
+	if perms&PermissionViewChannel == 0 {
+		return 0
+	}
+	return perms
+}
+
+// restrict takes away the permissions of a muted member from perms, administrators included
+func (m memberPermissions) restrict(perms Permission) Permission {
+	if !m.muted || perms == 0 {
+		return perms
+	}
+	if perms.Has(PermissionAdministrator) {
+		perms = PermissionAll &^ PermissionAdministrator
+	}
+	return perms &^ mutedPermissions
+}
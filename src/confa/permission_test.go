@@ -0,0 +1,157 @@
+package confa
+
+import (
+	"testing"
+
+	"github.com/confa-chat/node/pkg/uuid"
+	"github.com/confa-chat/node/src/store"
+)
+
+func TestMemberPermissionsInChannel(t *testing.T) {
+	serverID, userID, roleA, roleB := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	channelID := uuid.New()
+
+	overwrite := func(targetType string, targetID uuid.UUID, allow, deny Permission) store.ChannelOverwrite {
+		return store.ChannelOverwrite{ChannelID: channelID, TargetType: targetType, TargetID: targetID, Allow: int64(allow), Deny: int64(deny)}
+	}
+
+	tests := []struct {
+		name       string
+		member     memberPermissions
+		overwrites []store.ChannelOverwrite
+		expected   Permission
+	}{
+		{
+			name:     "no overwrites",
+			member:   memberPermissions{isMember: true, base: DefaultPermissions},
+			expected: DefaultPermissions,
+		},
+		{
+			name:       "not a member",
+			member:     memberPermissions{base: DefaultPermissions},
+			overwrites: []store.ChannelOverwrite{overwrite(store.OverwriteTargetMember, userID, PermissionViewChannel, 0)},
+			expected:   0,
+		},
+		{
+			name:       "everyone denied sending",
+			member:     memberPermissions{isMember: true, base: DefaultPermissions},
+			overwrites: []store.ChannelOverwrite{overwrite(store.OverwriteTargetRole, serverID, 0, PermissionSendMessages)},
+			expected:   DefaultPermissions &^ PermissionSendMessages,
+		},
+		{
+			name:   "role allow wins over everyone deny",
+			member: memberPermissions{isMember: true, base: DefaultPermissions, roleIDs: []uuid.UUID{roleA}},
+			overwrites: []store.ChannelOverwrite{
+				overwrite(store.OverwriteTargetRole, serverID, 0, PermissionSendMessages),
+				overwrite(store.OverwriteTargetRole, roleA, PermissionSendMessages, 0),
+			},
+			expected: DefaultPermissions,
+		},
+		{
+			name:   "role allow wins over another role deny",
+			member: memberPermissions{isMember: true, base: DefaultPermissions, roleIDs: []uuid.UUID{roleA, roleB}},
+			overwrites: []store.ChannelOverwrite{
+				overwrite(store.OverwriteTargetRole, roleA, PermissionManageMessages, PermissionSendMessages),
+				overwrite(store.OverwriteTargetRole, roleB, PermissionSendMessages, 0),
+			},
+			expected: DefaultPermissions | PermissionManageMessages,
+		},
+		{
+			name:   "member overwrite comes last",
+			member: memberPermissions{isMember: true, base: DefaultPermissions, roleIDs: []uuid.UUID{roleA}},
+			overwrites: []store.ChannelOverwrite{
+				overwrite(store.OverwriteTargetRole, roleA, PermissionSendMessages, 0),
+				overwrite(store.OverwriteTargetMember, userID, 0, PermissionSendMessages),
+			},
+			expected: DefaultPermissions &^ PermissionSendMessages,
+		},
+		{
+			name:       "hidden channel",
+			member:     memberPermissions{isMember: true, base: DefaultPermissions},
+			overwrites: []store.ChannelOverwrite{overwrite(store.OverwriteTargetRole, serverID, 0, PermissionViewChannel)},
+			expected:   0,
+		},
+		{
+			name:       "overwrites of roles the member doesn't have",
+			member:     memberPermissions{isMember: true, base: DefaultPermissions},
+			overwrites: []store.ChannelOverwrite{overwrite(store.OverwriteTargetRole, roleB, 0, PermissionViewChannel)},
+			expected:   DefaultPermissions,
+		},
+		{
+			name:       "administrator ignores overwrites",
+			member:     memberPermissions{isMember: true, base: PermissionAll},
+			overwrites: []store.ChannelOverwrite{overwrite(store.OverwriteTargetMember, userID, 0, PermissionViewChannel)},
+			expected:   PermissionAll,
+		},
+	}
+
+	for _, tt := range tests {
+		tt.member.userID = userID
+		tt.member.serverID = serverID
+		if got := tt.member.inChannel(tt.overwrites); got != tt.expected {
+			t.Errorf("%s: inChannel() = %b, expected %b", tt.name, got, tt.expected)
+		}
+	}
+}
+
+func TestMutedMemberPermissions(t *testing.T) {
+	tests := []struct {
+		name     string
+		member   memberPermissions
+		perms    Permission
+		expected Permission
+	}{
+		{
+			name:     "not muted",
+			member:   memberPermissions{isMember: true},
+			perms:    DefaultPermissions,
+			expected: DefaultPermissions,
+		},
+		{
+			name:     "muted",
+			member:   memberPermissions{isMember: true, muted: true},
+			perms:    DefaultPermissions,
+			expected: PermissionViewChannel | PermissionCreateInvites,
+		},
+		{
+			name:     "muted administrator",
+			member:   memberPermissions{isMember: true, muted: true},
+			perms:    PermissionAll,
+			expected: PermissionAll &^ (PermissionAdministrator | PermissionSendMessages | PermissionAddReactions),
+		},
+		{
+			name:     "muted in a hidden channel",
+			member:   memberPermissions{isMember: true, muted: true},
+			perms:    0,
+			expected: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		if got := tt.member.restrict(tt.perms); got != tt.expected {
+			t.Errorf("%s: restrict() = %b, expected %b", tt.name, got, tt.expected)
+		}
+	}
+}
+
+func TestOutranks(t *testing.T) {
+	moderator := DefaultPermissions | PermissionKickMembers
+	tests := []struct {
+		name          string
+		actor, target Permission
+		expected      bool
+	}{
+		{"moderator and member", moderator, DefaultPermissions, true},
+		{"member and moderator", DefaultPermissions, moderator, false},
+		{"same permissions", moderator, moderator, false},
+		{"different permissions", moderator, DefaultPermissions | PermissionMuteMembers, false},
+		{"administrator and moderator", PermissionAll, moderator, true},
+		{"administrators", PermissionAll, PermissionAll, false},
+	}
+
+	for _, tt := range tests {
+		if got := outranks(tt.actor, tt.target); got != tt.expected {
+			t.Errorf("%s: outranks() = %v, expected %v", tt.name, got, tt.expected)
+		}
+	}
+}
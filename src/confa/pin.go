@@ -0,0 +1,144 @@
+package confa
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/confa-chat/node/pkg/uuid"
+	"github.com/confa-chat/node/src/store"
+	"github.com/uptrace/bun"
+)
+
+// ErrPinLimitReached is returned when the channel already has the maximum number of pinned messages
+var ErrPinLimitReached = errors.New("pinned messages limit reached")
+
+// PinMessage pins a message to its channel, only moderators can pin messages
+func (c *Service) PinMessage(ctx context.Context, userID, serverID, channelID, messageID uuid.UUID) error {
+	log := c.log.With("user_id", userID, "server_id", serverID, "channel_id", channelID, "message_id", messageID)
+
+	if !c.hasPermission(ctx, userID, serverID, channelID, PermissionManageMessages) {
+		return ErrPermissionDenied
+	}
+
+	var msg store.Message
+	pinned := false
+	err := c.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		// Lock the channel so concurrent pins can't go over the limit
+		_, err := tx.NewSelect().
+			Model((*store.TextChannel)(nil)).
+			Where("id = ?", channelID).
+			For("UPDATE").
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		err = tx.NewSelect().
+			Model(&msg).
+			Where("id = ?", messageID).
+			Where("channel_id = ?", channelID).
+			Scan(ctx)
+		if err != nil {
+			return err
+		}
+		if msg.DeletedAt != nil {
+			return ErrMessageDeleted
+		}
+
+		count, err := tx.NewSelect().
+			Model((*store.PinnedMessage)(nil)).
+			Where("channel_id = ?", channelID).
+			Count(ctx)
+		if err != nil {
+			return err
+		}
+		if count >= c.Config.Chat.MaxPinsPerChannel {
+			return ErrPinLimitReached
+		}
+
+		res, err := tx.NewInsert().
+			Model(&store.PinnedMessage{
+				ChannelID: channelID,
+				MessageID: messageID,
+				PinnedBy:  &userID,
+				PinnedAt:  time.Now(),
+			}).
+			On("CONFLICT DO NOTHING").
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		affected, err := res.RowsAffected()
+		pinned = affected > 0
+		return err
+	})
+	if err != nil {
+		log.Error("failed to pin message", "error", err)
+		return err
+	}
+
+	if pinned {
+		c.publishEvent(channelID, newPinEvent(msg, true, userID))
+	}
+
+	return nil
+}
+
+// UnpinMessage removes a message from the pins of its channel, only moderators can unpin messages
+func (c *Service) UnpinMessage(ctx context.Context, userID, serverID, channelID, messageID uuid.UUID) error {
+	log := c.log.With("user_id", userID, "server_id", serverID, "channel_id", channelID, "message_id", messageID)
+
+	if !c.hasPermission(ctx, userID, serverID, channelID, PermissionManageMessages) {
+		return ErrPermissionDenied
+	}
+
+	res, err := c.db.NewDelete().
+		Model((*store.PinnedMessage)(nil)).
+		Where("channel_id = ?", channelID).
+		Where("message_id = ?", messageID).
+		Exec(ctx)
+	if err != nil {
+		log.Error("failed to unpin message", "error", err)
+		return err
+	}
+
+	if affected, _ := res.RowsAffected(); affected > 0 {
+		msg, err := c.GetMessage(ctx, serverID, channelID, messageID)
+		if err != nil {
+			return err
+		}
+		c.publishEvent(channelID, newPinEvent(msg, false, userID))
+	}
+
+	return nil
+}
+
+// ListPinnedMessages returns pinned messages of the channel, most recently pinned first
+func (c *Service) ListPinnedMessages(ctx context.Context, serverID, channelID uuid.UUID) ([]store.PinnedMessage, error) {
+	var pins []store.PinnedMessage
+	err := c.db.NewSelect().
+		Model(&pins).
+		Where("channel_id = ?", channelID).
+		Order("pinned_at DESC").
+		Scan(ctx)
+	if err != nil {
+		c.log.Error("failed to list pinned messages", "server_id", serverID, "channel_id", channelID, "error", err)
+		return nil, err
+	}
+
+	ids := make([]uuid.UUID, 0, len(pins))
+	for _, pin := range pins {
+		ids = append(ids, pin.MessageID)
+	}
+	messages, err := c.getMessagesByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for i := range pins {
+		msg := messages[pins[i].MessageID]
+		pins[i].Message = &msg
+	}
+
+	return pins, nil
+}
@@ -0,0 +1,136 @@
+package confa
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+	"unicode/utf8"
+
+	"github.com/confa-chat/node/pkg/uuid"
+	"github.com/confa-chat/node/src/store"
+	"github.com/uptrace/bun"
+)
+
+// maxEmojiLength limits the size of a reaction, long enough for multi-codepoint emoji and :custom_name: emoji
+const maxEmojiLength = 64
+
+// ErrInvalidReaction is returned when the reaction emoji is empty or too long
+var ErrInvalidReaction = errors.New("invalid reaction emoji")
+
+// AddReaction adds the user's reaction to a message, adding the same reaction twice has no effect
+func (c *Service) AddReaction(ctx context.Context, userID, serverID, channelID, messageID uuid.UUID, emoji string) error {
+	return c.changeReaction(ctx, userID, serverID, channelID, messageID, emoji, true)
+}
+
+// RemoveReaction removes the user's reaction from a message
+func (c *Service) RemoveReaction(ctx context.Context, userID, serverID, channelID, messageID uuid.UUID, emoji string) error {
+	return c.changeReaction(ctx, userID, serverID, channelID, messageID, emoji, false)
+}
+
+func (c *Service) changeReaction(ctx context.Context, userID, serverID, channelID, messageID uuid.UUID, emoji string, add bool) error {
+	log := c.log.With("user_id", userID, "server_id", serverID, "channel_id", channelID, "message_id", messageID, "emoji", emoji, "add", add)
+
+	if emoji == "" || len(emoji) > maxEmojiLength || !utf8.ValidString(emoji) {
+		return ErrInvalidReaction
+	}
+
+	var msg store.Message
+	var count int
+	changed := false
+	err := c.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		err := tx.NewSelect().
+			Model(&msg).
+			Where("id = ?", messageID).
+			Where("channel_id = ?", channelID).
+			Scan(ctx)
+		if err != nil {
+			return err
+		}
+		if msg.DeletedAt != nil {
+			return ErrMessageDeleted
+		}
+
+		reaction := store.MessageReaction{
+			MessageID: messageID,
+			UserID:    userID,
+			Emoji:     emoji,
+			CreatedAt: time.Now(),
+		}
+
+		var res sql.Result
+		if add {
+			res, err = tx.NewInsert().
+				Model(&reaction).
+				On("CONFLICT DO NOTHING").
+				Exec(ctx)
+		} else {
+			res, err = tx.NewDelete().
+				Model(&reaction).
+				WherePK().
+				Exec(ctx)
+		}
+		if err != nil {
+			return err
+		}
+		affected, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		changed = affected > 0
+
+		count, err = tx.NewSelect().
+			Model((*store.MessageReaction)(nil)).
+			Where("message_id = ?", messageID).
+			Where("emoji = ?", emoji).
+			Count(ctx)
+		return err
+	})
+	if err != nil {
+		log.Error("failed to change reaction", "error", err)
+		return err
+	}
+
+	if changed {
+		c.publishEvent(messageTopic(msg), newReactionEvent(msg, userID, emoji, add, count))
+	}
+
+	return nil
+}
+
+// LoadReactions fills the aggregated reactions of the messages as seen by the viewer
+func (c *Service) LoadReactions(ctx context.Context, viewerID uuid.UUID, msgs []store.Message) error {
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(msgs))
+	for _, msg := range msgs {
+		ids = append(ids, msg.ID)
+	}
+
+	var counts []store.ReactionCount
+	err := c.db.NewSelect().
+		Model((*store.MessageReaction)(nil)).
+		Column("message_id", "emoji").
+		ColumnExpr("count(*) AS count").
+		ColumnExpr("bool_or(user_id = ?) AS reacted_by_me", viewerID).
+		Where("message_id IN (?)", bun.In(ids)).
+		Group("message_id", "emoji").
+		OrderExpr("min(created_at) ASC").
+		Scan(ctx, &counts)
+	if err != nil {
+		c.log.Error("failed to load reactions", "viewer_id", viewerID, "error", err)
+		return err
+	}
+
+	byMessage := make(map[uuid.UUID][]store.ReactionCount, len(msgs))
+	for _, count := range counts {
+		byMessage[count.MessageID] = append(byMessage[count.MessageID], count)
+	}
+	for i := range msgs {
+		msgs[i].Reactions = byMessage[msgs[i].ID]
+	}
+
+	return nil
+}
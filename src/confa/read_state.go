@@ -0,0 +1,212 @@
+package confa
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/confa-chat/node/pkg/uuid"
+	chatv1 "github.com/confa-chat/node/src/proto/confa/chat/v1"
+	"github.com/confa-chat/node/src/store"
+	"github.com/cskr/pubsub/v2"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/schema"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ChannelUnread holds the unread counters of a channel for a user
+type ChannelUnread struct {
+	ChannelID         uuid.UUID  `bun:"channel_id"`
+	LastReadMessageID *uuid.UUID `bun:"last_read_message_id"`
+	UnreadCount       int        `bun:"unread_count"`
+	MentionCount      int        `bun:"mention_count"`
+}
+
+// MarkChannelRead moves the read marker of the user in the channel up to the given message,
+// or to the latest message when messageID is not set. The marker never moves backwards.
+// Mentions in messages up to the marker are acknowledged as well.
+// sql.ErrNoRows is returned when the message is not in the channel.
+func (c *Service) MarkChannelRead(ctx context.Context, userID, serverID, channelID, messageID uuid.UUID) (store.ReadState, error) {
+	log := c.log.With("user_id", userID, "server_id", serverID, "channel_id", channelID, "message_id", messageID)
+
+	state := store.ReadState{
+		UserID:            userID,
+		ChannelID:         channelID,
+		LastReadMessageID: messageID,
+		UpdatedAt:         time.Now(),
+	}
+
+	changed := false
+	err := c.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if state.LastReadMessageID == uuid.Nil {
+			err := tx.NewSelect().
+				Model((*store.Message)(nil)).
+				Column("id").
+				Where("channel_id = ?", channelID).
+				Where("parent_id IS NULL").
+				Order("id DESC").
+				Limit(1).
+				Scan(ctx, &state.LastReadMessageID)
+			if errors.Is(err, sql.ErrNoRows) {
+				// Nothing to read yet
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+		} else {
+			// An unknown ID could move the marker past every future message
+			exists, err := tx.NewSelect().
+				Model((*store.Message)(nil)).
+				Where("id = ?", state.LastReadMessageID).
+				Where("channel_id = ?", channelID).
+				Exists(ctx)
+			if err != nil {
+				return err
+			}
+			if !exists {
+				return sql.ErrNoRows
+			}
+		}
+
+		res, err := tx.NewInsert().
+			Model(&state).
+			On("CONFLICT (user_id, channel_id) DO UPDATE").
+			Set("last_read_message_id = EXCLUDED.last_read_message_id").
+			Set("updated_at = EXCLUDED.updated_at").
+			Where("read_state.last_read_message_id < EXCLUDED.last_read_message_id").
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		affected, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		changed = affected > 0
+
+		_, err = tx.NewUpdate().
+			Model((*store.Mention)(nil)).
+			Set("acknowledged_at = ?", state.UpdatedAt).
+			Where("user_id = ?", userID).
+			Where("channel_id = ?", channelID).
+			Where("message_id <= ?", state.LastReadMessageID).
+			Where("acknowledged_at IS NULL").
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		if !changed {
+			// Return the marker that is actually stored
+			return tx.NewSelect().
+				Model(&state).
+				WherePK().
+				Scan(ctx)
+		}
+		return nil
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return state, err
+	}
+	if err != nil {
+		log.Error("failed to mark channel read", "error", err)
+		return state, err
+	}
+
+	if changed {
+		c.readStateBroker.Pub(ReadStateToProto(state, serverID), userID)
+	}
+
+	return state, nil
+}
+
+// ListUnreadCounts returns unread counters of the user for every text channel on the server
+func (c *Service) ListUnreadCounts(ctx context.Context, userID, serverID uuid.UUID) (map[uuid.UUID]ChannelUnread, error) {
+	unreads, err := c.listUnreadCounts(ctx, userID, bun.SafeQuery("text_channel.server_id = ?", serverID))
+	if err != nil {
+		c.log.Error("failed to list unread counts", "user_id", userID, "server_id", serverID, "error", err)
+		return nil, err
+	}
+	return unreads, nil
+}
+
+// listUnreadCounts returns unread counters of the user for the text channels matching the condition
+func (c *Service) listUnreadCounts(ctx context.Context, userID uuid.UUID, where schema.QueryWithArgs) (map[uuid.UUID]ChannelUnread, error) {
+	var unreads []ChannelUnread
+	err := c.db.NewRaw(`
+		SELECT
+			text_channel.id AS channel_id,
+			read_state.last_read_message_id,
+			(
+				SELECT count(*) FROM message
+				WHERE message.channel_id = text_channel.id
+					AND message.parent_id IS NULL
+					AND message.deleted_at IS NULL
+					AND message.sender_id <> ?0
+					AND (read_state.last_read_message_id IS NULL OR message.id > read_state.last_read_message_id)
+			) AS unread_count,
+			(
+				SELECT count(*) FROM mention
+				WHERE mention.channel_id = text_channel.id
+					AND mention.user_id = ?0
+					AND mention.acknowledged_at IS NULL
+			) AS mention_count
+		FROM text_channel
+		LEFT JOIN read_state ON read_state.channel_id = text_channel.id AND read_state.user_id = ?0
+		WHERE ?1`,
+		userID, where,
+	).Scan(ctx, &unreads)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[uuid.UUID]ChannelUnread, len(unreads))
+	for _, unread := range unreads {
+		res[unread.ChannelID] = unread
+	}
+
+	return res, nil
+}
+
+// ReadStateToProto maps a stored read state to its API representation,
+// the server ID is left empty for channels outside of servers
+func ReadStateToProto(state store.ReadState, serverID uuid.UUID) *chatv1.ReadState {
+	protoState := &chatv1.ReadState{
+		Channel: &chatv1.TextChannelRef{
+			ChannelId: state.ChannelID.String(),
+		},
+		UpdatedAt: timestamppb.New(state.UpdatedAt),
+	}
+	if serverID != uuid.Nil {
+		protoState.Channel.ServerId = serverID.String()
+	}
+	if state.LastReadMessageID != uuid.Nil {
+		protoState.LastReadMessageId = state.LastReadMessageID.String()
+	}
+	return protoState
+}
+
+type ReadStateSubscription struct {
+	UserID     uuid.UUID
+	ReadStates chan *chatv1.ReadState
+
+	readStateBroker *pubsub.PubSub[uuid.UUID, *chatv1.ReadState]
+}
+
+func (r *ReadStateSubscription) Close() {
+	r.readStateBroker.Unsub(r.ReadStates, r.UserID)
+	// Drain the channel
+	for range r.ReadStates {
+	}
+}
+
+// SubscribeReadState subscribes to read marker changes of the user, made from any of their devices
+func (c *Service) SubscribeReadState(ctx context.Context, userID uuid.UUID) (*ReadStateSubscription, error) {
+	return &ReadStateSubscription{
+		UserID:          userID,
+		ReadStates:      c.readStateBroker.Sub(userID),
+		readStateBroker: c.readStateBroker,
+	}, nil
+}
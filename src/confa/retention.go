@@ -0,0 +1,226 @@
+package confa
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/confa-chat/node/pkg/uuid"
+	"github.com/confa-chat/node/src/store"
+	"github.com/uptrace/bun"
+)
+
+const (
+	// reaperInterval is how often expired messages are deleted
+	reaperInterval = 30 * time.Second
+	// reaperBatchSize is the number of messages deleted in a single transaction
+	reaperBatchSize = 100
+)
+
+// ErrInvalidTTL is returned when a message or channel TTL is negative
+var ErrInvalidTTL = errors.New("invalid TTL")
+
+// SetChannelMessageTTL sets how long messages are kept in the channel, zero keeps them forever.
+// The TTL also applies to messages sent before it was set, sql.ErrNoRows is returned when the channel isn't on the server.
+func (c *Service) SetChannelMessageTTL(ctx context.Context, userID, serverID, channelID uuid.UUID, ttl time.Duration) (store.TextChannel, error) {
+	log := c.log.With("user_id", userID, "server_id", serverID, "channel_id", channelID, "ttl", ttl)
+
+	var channel store.TextChannel
+	if !c.hasPermission(ctx, userID, serverID, channelID, PermissionManageChannels) {
+		return channel, ErrPermissionDenied
+	}
+	if ttl < 0 {
+		return channel, ErrInvalidTTL
+	}
+
+	var seconds *int
+	if ttl > 0 {
+		s := int(ttl.Round(time.Second) / time.Second)
+		seconds = &s
+	}
+
+	res, err := c.db.NewUpdate().
+		Model(&channel).
+		Set("message_ttl_seconds = ?", seconds).
+		Where("id = ?", channelID).
+		Where("server_id = ?", serverID).
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		log.Error("failed to set channel message TTL", "error", err)
+		return channel, err
+	}
+	// The permission is resolved from the channel alone, a channel of another server is not found here
+	if updated, _ := res.RowsAffected(); updated == 0 {
+		return channel, sql.ErrNoRows
+	}
+
+	return channel, nil
+}
+
+// RunMessageReaper deletes expired messages, bans and mutes until the context is done.
+// Messages are deleted in small batches, so the message table is never locked for long.
+func (c *Service) RunMessageReaper(ctx context.Context) {
+	ticker := time.NewTicker(reaperInterval)
+	defer ticker.Stop()
+
+	for {
+		c.reapExpiredMessages(ctx)
+		c.deleteExpiredSanctions(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (c *Service) reapExpiredMessages(ctx context.Context) {
+	c.reapAll(ctx, func(q *bun.SelectQuery) *bun.SelectQuery {
+		return q.Where("expires_at <= now()")
+	})
+
+	var channels []store.TextChannel
+	err := c.db.NewSelect().
+		Model(&channels).
+		Where("message_ttl_seconds IS NOT NULL").
+		Scan(ctx)
+	if err != nil {
+		c.log.Error("failed to list channels with message TTL", "error", err)
+		return
+	}
+
+	for _, channel := range channels {
+		cutoff := time.Now().Add(-time.Duration(*channel.MessageTTLSeconds) * time.Second)
+		c.reapAll(ctx, func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.Where("channel_id = ?", channel.ID).Where("timestamp <= ?", cutoff)
+		})
+	}
+}
+
+// reapAll deletes batches of messages matching the filter until none is left
+func (c *Service) reapAll(ctx context.Context, filter func(*bun.SelectQuery) *bun.SelectQuery) {
+	for ctx.Err() == nil {
+		n, err := c.reapBatch(ctx, filter)
+		if err != nil {
+			c.log.Error("failed to delete expired messages", "error", err)
+			return
+		}
+		if n < reaperBatchSize {
+			return
+		}
+	}
+}
+
+// reapBatch hard deletes a batch of messages matching the filter with their replies,
+// removes their blobs from the attachment storage and publishes deletion events
+func (c *Service) reapBatch(ctx context.Context, filter func(*bun.SelectQuery) *bun.SelectQuery) (int, error) {
+	var count int
+	var deleted []store.Message
+	var attachments []store.MessageAttachment
+	var previews []store.LinkPreview
+	err := c.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		var ids []uuid.UUID
+		err := filter(tx.NewSelect().Model((*store.Message)(nil)).Column("id")).
+			Limit(reaperBatchSize).
+			For("UPDATE SKIP LOCKED").
+			Scan(ctx, &ids)
+		if err != nil {
+			return err
+		}
+		count = len(ids)
+		if count == 0 {
+			return nil
+		}
+
+		// Replies would be removed by the cascade, load them so their blobs are deleted too
+		var replyIDs []uuid.UUID
+		err = tx.NewSelect().
+			Model((*store.Message)(nil)).
+			Column("id").
+			Where("parent_id IN (?)", bun.In(ids)).
+			Scan(ctx, &replyIDs)
+		if err != nil {
+			return err
+		}
+		ids = append(ids, replyIDs...)
+
+		_, err = tx.NewDelete().
+			Model(&attachments).
+			Where("message_id IN (?)", bun.In(ids)).
+			Returning("*").
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.NewDelete().
+			Model(&previews).
+			Where("message_id IN (?)", bun.In(ids)).
+			Returning("*").
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.NewDelete().
+			Model(&deleted).
+			Where("id IN (?)", bun.In(ids)).
+			Returning("*").
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		// Threads which outlive their expired replies
+		removedReplies := map[uuid.UUID]int{}
+		for _, msg := range deleted {
+			if msg.ParentID != nil {
+				removedReplies[*msg.ParentID]++
+			}
+		}
+		for parentID, n := range removedReplies {
+			_, err = tx.NewUpdate().
+				Model((*store.Message)(nil)).
+				Set("reply_count = GREATEST(reply_count - ?, 0)", n).
+				Where("id = ?", parentID).
+				Exec(ctx)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	c.deleteUnreferencedAttachments(ctx, attachments)
+	c.deleteThumbnails(ctx, previews)
+
+	deletedAt := time.Now()
+	deletedIDs := map[uuid.UUID]bool{}
+	for _, msg := range deleted {
+		deletedIDs[msg.ID] = true
+	}
+
+	threads := map[uuid.UUID]uuid.UUID{}
+	for _, msg := range deleted {
+		msg.Content = ""
+		msg.ContentNodes = nil
+		msg.DeletedAt = &deletedAt
+		c.publishMessageDeleted(msg)
+
+		if msg.ParentID != nil && !deletedIDs[*msg.ParentID] {
+			threads[*msg.ParentID] = msg.ChannelID
+		}
+	}
+	for parentID, channelID := range threads {
+		c.publishThreadUpdated(ctx, uuid.Nil, channelID, parentID)
+	}
+
+	return count, nil
+}
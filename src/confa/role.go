@@ -0,0 +1,431 @@
+package confa
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/confa-chat/node/pkg/uuid"
+	"github.com/confa-chat/node/src/store"
+	"github.com/uptrace/bun"
+)
+
+var (
+	// ErrInvalidRoleName is returned when a role name is empty or too long
+	ErrInvalidRoleName = errors.New("invalid role name")
+	// ErrEveryoneRole is returned when deleting, renaming or assigning the @everyone role
+	ErrEveryoneRole = errors.New("the @everyone role can't be changed this way")
+	// ErrInvalidPermissions is returned for unknown permissions, or administrator in a channel overwrite
+	ErrInvalidPermissions = errors.New("invalid permissions")
+	// ErrInvalidOverwrite is returned when overwriting permissions outside of a server channel or for an unknown target
+	ErrInvalidOverwrite = errors.New("invalid permission overwrite")
+)
+
+const (
+	// everyoneRoleName is the name of the role every member of a server has
+	everyoneRoleName = "@everyone"
+	// maxRoleNameLength is the maximum length of a role name in characters
+	maxRoleNameLength = 100
+)
+
+// checkGrant returns ErrPermissionDenied unless the user can manage roles and has every permission of perms,
+// so nobody can give away or take back permissions they don't have
+func (c *Service) checkGrant(ctx context.Context, userID, serverID, channelID uuid.UUID, perms Permission) error {
+	actor, err := c.Permissions(ctx, userID, serverID, channelID)
+	if err != nil {
+		return err
+	}
+	if !actor.Has(PermissionManageRoles) || !actor.Has(perms) {
+		return ErrPermissionDenied
+	}
+	return nil
+}
+
+// CreateRole adds a role to the server
+func (c *Service) CreateRole(ctx context.Context, userID, serverID uuid.UUID, name string, perms Permission) (store.Role, error) {
+	log := c.log.With("user_id", userID, "server_id", serverID, "name", name)
+
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > maxRoleNameLength {
+		return store.Role{}, ErrInvalidRoleName
+	}
+	if perms&^PermissionAll != 0 {
+		return store.Role{}, ErrInvalidPermissions
+	}
+	if err := c.checkGrant(ctx, userID, serverID, uuid.Nil, perms); err != nil {
+		return store.Role{}, err
+	}
+
+	role := store.Role{
+		ID:          uuid.New(),
+		ServerID:    serverID,
+		Name:        name,
+		Permissions: int64(perms),
+		CreatedAt:   time.Now(),
+	}
+	_, err := c.db.NewInsert().
+		Model(&role).
+		Exec(ctx)
+	if err != nil {
+		log.Error("failed to create role", "error", err)
+		return role, err
+	}
+
+	return role, nil
+}
+
+// ListRoles returns the roles of the server, the @everyone role first
+func (c *Service) ListRoles(ctx context.Context, userID, serverID uuid.UUID) ([]store.Role, error) {
+	if err := c.CheckServerAccess(ctx, userID, serverID); err != nil {
+		return nil, err
+	}
+
+	var roles []store.Role
+	err := c.db.NewSelect().
+		Model(&roles).
+		Where("server_id = ?", serverID).
+		OrderExpr("id = server_id DESC, created_at, id").
+		Scan(ctx)
+	if err != nil {
+		c.log.Error("failed to list roles", "server_id", serverID, "error", err)
+		return nil, err
+	}
+
+	return roles, nil
+}
+
+func (c *Service) getRole(ctx context.Context, roleID uuid.UUID) (store.Role, error) {
+	var role store.Role
+	err := c.db.NewSelect().
+		Model(&role).
+		Where("id = ?", roleID).
+		Scan(ctx)
+	return role, err
+}
+
+// UpdateRole changes the name and the permissions of a role, an empty name keeps the current one.
+// The @everyone role can't be renamed.
+func (c *Service) UpdateRole(ctx context.Context, userID, roleID uuid.UUID, name string, perms Permission) (store.Role, error) {
+	log := c.log.With("user_id", userID, "role_id", roleID, "name", name)
+
+	name = strings.TrimSpace(name)
+	if utf8.RuneCountInString(name) > maxRoleNameLength {
+		return store.Role{}, ErrInvalidRoleName
+	}
+	if perms&^PermissionAll != 0 {
+		return store.Role{}, ErrInvalidPermissions
+	}
+
+	role, err := c.getRole(ctx, roleID)
+	if err != nil {
+		log.Error("failed to get role", "error", err)
+		return role, err
+	}
+	if role.ID == role.ServerID && name != "" && name != role.Name {
+		return role, ErrEveryoneRole
+	}
+	if err := c.checkGrant(ctx, userID, role.ServerID, uuid.Nil, perms|Permission(role.Permissions)); err != nil {
+		return role, err
+	}
+
+	if name != "" {
+		role.Name = name
+	}
+	role.Permissions = int64(perms)
+	_, err = c.db.NewUpdate().
+		Model(&role).
+		Column("name", "permissions").
+		WherePK().
+		Exec(ctx)
+	if err != nil {
+		log.Error("failed to update role", "error", err)
+		return role, err
+	}
+
+	c.recheckSubscriptions(ctx, role.ServerID)
+
+	return role, nil
+}
+
+// DeleteRole removes a role from the server, its members and its channel overwrites
+func (c *Service) DeleteRole(ctx context.Context, userID, roleID uuid.UUID) error {
+	log := c.log.With("user_id", userID, "role_id", roleID)
+
+	role, err := c.getRole(ctx, roleID)
+	if err != nil {
+		log.Error("failed to get role", "error", err)
+		return err
+	}
+	if role.ID == role.ServerID {
+		return ErrEveryoneRole
+	}
+	if err := c.checkGrant(ctx, userID, role.ServerID, uuid.Nil, Permission(role.Permissions)); err != nil {
+		return err
+	}
+
+	err = c.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		_, err := tx.NewDelete().
+			Model((*store.ChannelOverwrite)(nil)).
+			Where("target_type = ?", store.OverwriteTargetRole).
+			Where("target_id = ?", roleID).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.NewDelete().
+			Model(&role).
+			WherePK().
+			Exec(ctx)
+		return err
+	})
+	if err != nil {
+		log.Error("failed to delete role", "error", err)
+		return err
+	}
+
+	c.recheckSubscriptions(ctx, role.ServerID)
+
+	return nil
+}
+
+// AssignRole gives a role to a member of its server
+func (c *Service) AssignRole(ctx context.Context, userID, memberID, roleID uuid.UUID) error {
+	log := c.log.With("user_id", userID, "member_id", memberID, "role_id", roleID)
+
+	role, err := c.assignableRole(ctx, userID, memberID, roleID)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.db.NewInsert().
+		Model(&store.MemberRole{ServerID: role.ServerID, UserID: memberID, RoleID: roleID}).
+		On("CONFLICT DO NOTHING").
+		Exec(ctx)
+	if err != nil {
+		log.Error("failed to assign role", "error", err)
+		return err
+	}
+
+	c.recheckSubscriptions(ctx, role.ServerID)
+
+	return nil
+}
+
+// UnassignRole takes a role back from a member of its server
+func (c *Service) UnassignRole(ctx context.Context, userID, memberID, roleID uuid.UUID) error {
+	log := c.log.With("user_id", userID, "member_id", memberID, "role_id", roleID)
+
+	role, err := c.assignableRole(ctx, userID, memberID, roleID)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.db.NewDelete().
+		Model((*store.MemberRole)(nil)).
+		Where("server_id = ?", role.ServerID).
+		Where("user_id = ?", memberID).
+		Where("role_id = ?", roleID).
+		Exec(ctx)
+	if err != nil {
+		log.Error("failed to unassign role", "error", err)
+		return err
+	}
+
+	c.recheckSubscriptions(ctx, role.ServerID)
+
+	return nil
+}
+
+// assignableRole returns the role when the user is allowed to assign it to the member
+func (c *Service) assignableRole(ctx context.Context, userID, memberID, roleID uuid.UUID) (store.Role, error) {
+	role, err := c.getRole(ctx, roleID)
+	if err != nil {
+		c.log.Error("failed to get role", "role_id", roleID, "error", err)
+		return role, err
+	}
+	if role.ID == role.ServerID {
+		return role, ErrEveryoneRole
+	}
+	if err := c.checkGrant(ctx, userID, role.ServerID, uuid.Nil, Permission(role.Permissions)); err != nil {
+		return role, err
+	}
+
+	if err := c.CheckServerAccess(ctx, memberID, role.ServerID); errors.Is(err, ErrPermissionDenied) {
+		return role, ErrNotServerMember
+	} else if err != nil {
+		return role, err
+	}
+
+	return role, nil
+}
+
+// listMemberRoles fills the roles of the members of a server
+func (c *Service) listMemberRoles(ctx context.Context, serverID uuid.UUID, members []store.ServerMember) error {
+	if len(members) == 0 {
+		return nil
+	}
+
+	userIDs := make([]uuid.UUID, len(members))
+	for i, member := range members {
+		userIDs[i] = member.UserID
+	}
+
+	var assigned []store.MemberRole
+	err := c.db.NewSelect().
+		Model(&assigned).
+		Where("server_id = ?", serverID).
+		Where("user_id IN (?)", bun.In(userIDs)).
+		Scan(ctx)
+	if err != nil {
+		return err
+	}
+
+	for i := range members {
+		for _, a := range assigned {
+			if a.UserID == members[i].UserID {
+				members[i].RoleIDs = append(members[i].RoleIDs, a.RoleID)
+			}
+		}
+	}
+	return nil
+}
+
+// SetChannelOverwrite allows and denies permissions to a role or a member in a server channel,
+// replacing the previous overwrite of the same target
+func (c *Service) SetChannelOverwrite(ctx context.Context, userID uuid.UUID, overwrite store.ChannelOverwrite) (store.ChannelOverwrite, error) {
+	log := c.log.With("user_id", userID, "channel_id", overwrite.ChannelID, "target_id", overwrite.TargetID)
+
+	allow, deny := Permission(overwrite.Allow), Permission(overwrite.Deny)
+	if (allow|deny)&^PermissionAll != 0 || (allow | deny).Has(PermissionAdministrator) {
+		return overwrite, ErrInvalidPermissions
+	}
+
+	serverID, err := c.overwriteServer(ctx, overwrite.ChannelID)
+	if err != nil {
+		return overwrite, err
+	}
+	if err := c.checkGrant(ctx, userID, serverID, overwrite.ChannelID, allow|deny); err != nil {
+		return overwrite, err
+	}
+
+	var targetExists bool
+	switch overwrite.TargetType {
+	case store.OverwriteTargetRole:
+		targetExists, err = c.db.NewSelect().
+			Model((*store.Role)(nil)).
+			Where("id = ?", overwrite.TargetID).
+			Where("server_id = ?", serverID).
+			Exists(ctx)
+	case store.OverwriteTargetMember:
+		targetExists, err = c.db.NewSelect().
+			Model((*store.ServerMember)(nil)).
+			Where("server_id = ?", serverID).
+			Where("user_id = ?", overwrite.TargetID).
+			Exists(ctx)
+	}
+	if err != nil {
+		log.Error("failed to get overwrite target", "error", err)
+		return overwrite, err
+	}
+	if !targetExists {
+		return overwrite, ErrInvalidOverwrite
+	}
+
+	_, err = c.db.NewInsert().
+		Model(&overwrite).
+		On("CONFLICT (channel_id, target_id) DO UPDATE").
+		Set("target_type = EXCLUDED.target_type").
+		Set("allow = EXCLUDED.allow").
+		Set("deny = EXCLUDED.deny").
+		Exec(ctx)
+	if err != nil {
+		log.Error("failed to set channel overwrite", "error", err)
+		return overwrite, err
+	}
+
+	c.recheckSubscriptions(ctx, serverID)
+
+	return overwrite, nil
+}
+
+// DeleteChannelOverwrite removes the overwrite of a role or a member in a server channel
+func (c *Service) DeleteChannelOverwrite(ctx context.Context, userID, channelID, targetID uuid.UUID) error {
+	log := c.log.With("user_id", userID, "channel_id", channelID, "target_id", targetID)
+
+	serverID, err := c.overwriteServer(ctx, channelID)
+	if err != nil {
+		return err
+	}
+
+	var overwrite store.ChannelOverwrite
+	err = c.db.NewSelect().
+		Model(&overwrite).
+		Where("channel_id = ?", channelID).
+		Where("target_id = ?", targetID).
+		Scan(ctx)
+	if err != nil {
+		log.Error("failed to get channel overwrite", "error", err)
+		return err
+	}
+	if err := c.checkGrant(ctx, userID, serverID, channelID, Permission(overwrite.Allow|overwrite.Deny)); err != nil {
+		return err
+	}
+
+	_, err = c.db.NewDelete().
+		Model(&overwrite).
+		WherePK().
+		Exec(ctx)
+	if err != nil {
+		log.Error("failed to delete channel overwrite", "error", err)
+		return err
+	}
+
+	c.recheckSubscriptions(ctx, serverID)
+
+	return nil
+}
+
+// ListChannelOverwrites returns the overwrites of a channel the user can view
+func (c *Service) ListChannelOverwrites(ctx context.Context, userID, channelID uuid.UUID) ([]store.ChannelOverwrite, error) {
+	if err := c.CheckPermission(ctx, userID, uuid.Nil, channelID, PermissionViewChannel); err != nil {
+		return nil, err
+	}
+
+	var overwrites []store.ChannelOverwrite
+	err := c.db.NewSelect().
+		Model(&overwrites).
+		Where("channel_id = ?", channelID).
+		Order("target_type", "target_id").
+		Scan(ctx)
+	if err != nil {
+		c.log.Error("failed to list channel overwrites", "channel_id", channelID, "error", err)
+		return nil, err
+	}
+
+	return overwrites, nil
+}
+
+// overwriteServer returns the server of a channel which can have permission overwrites
+func (c *Service) overwriteServer(ctx context.Context, channelID uuid.UUID) (uuid.UUID, error) {
+	var channel store.TextChannel
+	err := c.db.NewSelect().
+		Model(&channel).
+		Column("id", "server_id").
+		Where("id = ?", channelID).
+		Scan(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return uuid.Nil, err
+	}
+	if err != nil {
+		c.log.Error("failed to get channel", "channel_id", channelID, "error", err)
+		return uuid.Nil, err
+	}
+	if channel.ServerID == nil {
+		return uuid.Nil, ErrInvalidOverwrite
+	}
+	return *channel.ServerID, nil
+}
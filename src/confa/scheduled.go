@@ -0,0 +1,298 @@
+package confa
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/confa-chat/node/pkg/uuid"
+	"github.com/confa-chat/node/src/store"
+	"github.com/uptrace/bun"
+)
+
+const (
+	// scheduledPollInterval is how often the node looks for due scheduled messages
+	scheduledPollInterval = 5 * time.Second
+	// scheduledLease is how long a node owns a scheduled message it started to deliver,
+	// after it the message is picked up again in case the node stopped
+	scheduledLease = time.Minute
+	// scheduledMaxAttempts is how many times the delivery is tried before the message is dropped
+	scheduledMaxAttempts = 5
+	// maxScheduleAhead limits how far in the future a message can be scheduled
+	maxScheduleAhead = 365 * 24 * time.Hour
+)
+
+var (
+	// ErrInvalidSendTime is returned when the message is scheduled in the past or too far in the future
+	ErrInvalidSendTime = errors.New("invalid send time")
+	// ErrScheduledMessageSending is returned when cancelling a scheduled message which is already being sent
+	ErrScheduledMessageSending = errors.New("scheduled message is already being sent")
+)
+
+// ScheduleMessage stores a message to be sent to the channel at sendAt.
+// The server of the message is the one of the channel, serverID is only logged.
+func (c *Service) ScheduleMessage(ctx context.Context, senderID, serverID, channelID, replyToID uuid.UUID, content string, attachmentIDs []uuid.UUID, attachmentNames []string, sendAt time.Time) (store.ScheduledMessage, error) {
+	log := c.log.With("sender_id", senderID, "server_id", serverID, "channel_id", channelID, "send_at", sendAt)
+
+	now := time.Now()
+	if !sendAt.After(now) || sendAt.Sub(now) > maxScheduleAhead {
+		return store.ScheduledMessage{}, ErrInvalidSendTime
+	}
+
+	var channel store.TextChannel
+	err := c.db.NewSelect().
+		Model(&channel).
+		Column("id", "server_id").
+		Where("id = ?", channelID).
+		Scan(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return store.ScheduledMessage{}, err
+	}
+	if err != nil {
+		log.Error("failed to get channel", "error", err)
+		return store.ScheduledMessage{}, err
+	}
+
+	scheduled := store.ScheduledMessage{
+		ID:          uuid.New(),
+		ServerID:    channel.ServerID,
+		ChannelID:   channelID,
+		SenderID:    senderID,
+		Content:     content,
+		Attachments: make([]store.ScheduledAttachment, len(attachmentIDs)),
+		SendAt:      sendAt,
+		CreatedAt:   now,
+	}
+	if replyToID != uuid.Nil {
+		scheduled.ReplyToID = &replyToID
+	}
+	for i := range attachmentIDs {
+		scheduled.Attachments[i] = store.ScheduledAttachment{ID: attachmentIDs[i], Name: attachmentNames[i]}
+	}
+
+	_, err = c.db.NewInsert().Model(&scheduled).Exec(ctx)
+	if err != nil {
+		log.Error("failed to schedule message", "error", err)
+		return scheduled, err
+	}
+
+	return scheduled, nil
+}
+
+// ListScheduledMessages returns pending scheduled messages of the user, soonest first.
+// If channelID is set, only messages scheduled in that channel are returned.
+func (c *Service) ListScheduledMessages(ctx context.Context, senderID, channelID uuid.UUID) ([]store.ScheduledMessage, error) {
+	var scheduled []store.ScheduledMessage
+	q := c.db.NewSelect().
+		Model(&scheduled).
+		Where("sender_id = ?", senderID).
+		Order("send_at ASC")
+	if channelID != uuid.Nil {
+		q = q.Where("channel_id = ?", channelID)
+	}
+
+	if err := q.Scan(ctx); err != nil {
+		c.log.Error("failed to list scheduled messages", "sender_id", senderID, "channel_id", channelID, "error", err)
+		return nil, err
+	}
+
+	return scheduled, nil
+}
+
+// CancelScheduledMessage removes a scheduled message of the user which is not being sent yet
+func (c *Service) CancelScheduledMessage(ctx context.Context, senderID, scheduledID uuid.UUID) error {
+	log := c.log.With("sender_id", senderID, "scheduled_message_id", scheduledID)
+
+	res, err := c.db.NewDelete().
+		Model((*store.ScheduledMessage)(nil)).
+		Where("id = ?", scheduledID).
+		Where("sender_id = ?", senderID).
+		Where("message_id IS NULL").
+		Exec(ctx)
+	if err != nil {
+		log.Error("failed to cancel scheduled message", "error", err)
+		return err
+	}
+	if affected, _ := res.RowsAffected(); affected > 0 {
+		return nil
+	}
+
+	exists, err := c.db.NewSelect().
+		Model((*store.ScheduledMessage)(nil)).
+		Where("id = ?", scheduledID).
+		Where("sender_id = ?", senderID).
+		Exists(ctx)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return ErrScheduledMessageSending
+	}
+	return sql.ErrNoRows
+}
+
+// RunScheduledMessages delivers due scheduled messages until the context is done.
+// Several nodes can run it on the same database, each message is delivered by one of them.
+func (c *Service) RunScheduledMessages(ctx context.Context) {
+	ticker := time.NewTicker(scheduledPollInterval)
+	defer ticker.Stop()
+
+	for {
+		c.deliverDueMessages(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (c *Service) deliverDueMessages(ctx context.Context) {
+	for {
+		due, err := c.claimDueMessages(ctx, 10)
+		if err != nil {
+			c.log.Error("failed to claim scheduled messages", "error", err)
+			return
+		}
+		if len(due) == 0 {
+			return
+		}
+
+		for _, scheduled := range due {
+			c.deliverScheduledMessage(ctx, scheduled)
+		}
+	}
+}
+
+// claimDueMessages takes a lease on up to count due messages and assigns the IDs they will be posted with
+func (c *Service) claimDueMessages(ctx context.Context, count int) ([]store.ScheduledMessage, error) {
+	var due []store.ScheduledMessage
+	err := c.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		err := tx.NewSelect().
+			Model(&due).
+			Where("send_at <= now()").
+			Where("locked_until IS NULL OR locked_until < now()").
+			Order("send_at ASC").
+			Limit(count).
+			For("UPDATE SKIP LOCKED").
+			Scan(ctx)
+		if err != nil {
+			return err
+		}
+
+		lockedUntil := time.Now().Add(scheduledLease)
+		for i := range due {
+			if due[i].MessageID == nil {
+				due[i].MessageID = uuid.NewP()
+			}
+			due[i].LockedUntil = &lockedUntil
+			due[i].Attempts++
+
+			_, err := tx.NewUpdate().
+				Model(&due[i]).
+				Column("message_id", "locked_until", "attempts").
+				WherePK().
+				Exec(ctx)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	return due, err
+}
+
+// deliverScheduledMessage posts the message and removes it from the schedule.
+// A message whose ID already exists was posted by an earlier attempt which didn't finish.
+func (c *Service) deliverScheduledMessage(ctx context.Context, scheduled store.ScheduledMessage) {
+	log := c.log.With("scheduled_message_id", scheduled.ID, "message_id", *scheduled.MessageID, "attempt", scheduled.Attempts)
+
+	posted, err := c.db.NewSelect().
+		Model((*store.Message)(nil)).
+		Where("id = ?", *scheduled.MessageID).
+		Exists(ctx)
+	if err != nil {
+		log.Error("failed to check scheduled message delivery", "error", err)
+		return
+	}
+
+	if !posted {
+		replyToID := uuid.Nil
+		if scheduled.ReplyToID != nil {
+			replyToID = *scheduled.ReplyToID
+		}
+
+		var attachmentIDs []uuid.UUID
+		var attachmentNames []string
+		for _, a := range scheduled.Attachments {
+			attachmentIDs = append(attachmentIDs, a.ID)
+			attachmentNames = append(attachmentNames, a.Name)
+		}
+
+		serverID := uuid.Nil
+		if scheduled.ServerID != nil {
+			serverID = *scheduled.ServerID
+		}
+
+		_, err = c.sendMessage(ctx, *scheduled.MessageID, scheduled.SenderID, serverID, scheduled.ChannelID, replyToID, scheduled.Content, attachmentIDs, attachmentNames, 0)
+		if errors.Is(err, ErrMuted) {
+			c.postponeUntilUnmuted(ctx, scheduled, serverID)
+			return
+		}
+		if errors.Is(err, ErrPermissionDenied) {
+			log.Warn("sender can't post in the channel anymore, dropping scheduled message")
+		} else if err != nil {
+			if scheduled.Attempts < scheduledMaxAttempts {
+				log.Warn("failed to send scheduled message, will retry", "error", err)
+				return
+			}
+			log.Error("failed to send scheduled message, giving up", "error", err)
+		}
+	}
+
+	_, err = c.db.NewDelete().
+		Model((*store.ScheduledMessage)(nil)).
+		Where("id = ?", scheduled.ID).
+		Exec(ctx)
+	if err != nil {
+		log.Error("failed to remove delivered scheduled message", "error", err)
+	}
+}
+
+// postponeUntilUnmuted moves a message of a muted sender to the end of their mute, with its attempts reset.
+// If the mute is already gone, the lease runs out and the message is retried as usual.
+func (c *Service) postponeUntilUnmuted(ctx context.Context, scheduled store.ScheduledMessage, serverID uuid.UUID) {
+	log := c.log.With("scheduled_message_id", scheduled.ID, "sender_id", scheduled.SenderID, "server_id", serverID)
+
+	var mute store.ServerMute
+	err := c.db.NewSelect().
+		Model(&mute).
+		Where("server_id = ?", serverID).
+		Where("user_id = ?", scheduled.SenderID).
+		Scan(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return
+	}
+	if err != nil {
+		log.Error("failed to get the mute of the sender", "error", err)
+		return
+	}
+
+	scheduled.SendAt = mute.ExpiresAt
+	scheduled.LockedUntil = nil
+	scheduled.Attempts = 0
+	_, err = c.db.NewUpdate().
+		Model(&scheduled).
+		Column("send_at", "locked_until", "attempts").
+		WherePK().
+		Exec(ctx)
+	if err != nil {
+		log.Error("failed to postpone scheduled message", "error", err)
+		return
+	}
+
+	log.Info("sender is muted, scheduled message postponed", "send_at", mute.ExpiresAt)
+}
@@ -0,0 +1,179 @@
+package confa
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"strings"
+	"time"
+
+	"github.com/confa-chat/node/pkg/uuid"
+	"github.com/confa-chat/node/src/store"
+)
+
+var (
+	// ErrEmptySearchQuery is returned when searching without a query
+	ErrEmptySearchQuery = errors.New("search query is empty")
+	// ErrInvalidCount is returned when a page of results is requested with a count or offset below 1 or 0
+	ErrInvalidCount = errors.New("invalid count")
+)
+
+const (
+	// searchConfig is the text search configuration used for the message search_vector column
+	searchConfig = "simple"
+	// maxSearchResults is the maximum number of results in a page, larger counts are clamped
+	maxSearchResults = 100
+
+	// Matches are delimited with control characters in the headline, so the content can be escaped
+	// before they are replaced with markup
+	headlineStartSel = "\x02"
+	headlineStopSel  = "\x03"
+)
+
+// headlineOptions are the ts_headline options of search snippets
+var headlineOptions = fmt.Sprintf(`StartSel="%s", StopSel="%s", MaxFragments=2`, headlineStartSel, headlineStopSel)
+
+// highlightSnippet escapes the HTML of a ts_headline snippet and wraps the matches in <mark></mark>
+func highlightSnippet(snippet string) string {
+	return strings.NewReplacer(headlineStartSel, "<mark>", headlineStopSel, "</mark>").Replace(html.EscapeString(snippet))
+}
+
+// SearchFilter narrows down message search results, zero values are ignored
+type SearchFilter struct {
+	Query string
+	// UserID is the user searching, only the channels they can access are searched
+	UserID        uuid.UUID
+	ServerID      uuid.UUID
+	ChannelID     uuid.UUID
+	AuthorID      uuid.UUID
+	From          time.Time
+	To            time.Time
+	HasAttachment *bool
+}
+
+// SearchResult is a message matching a search query
+type SearchResult struct {
+	// ServerID is nil for channels outside of servers
+	ServerID *uuid.UUID
+	Message  store.Message
+	// Snippet is a fragment of the message content, HTML escaped, with matches wrapped in <mark></mark>
+	Snippet string
+	Rank    float32
+}
+
+type searchHit struct {
+	ID       uuid.UUID  `bun:"id"`
+	ServerID *uuid.UUID `bun:"server_id"`
+	Rank     float32    `bun:"rank"`
+	Snippet  string     `bun:"snippet"`
+}
+
+// SearchMessages finds messages matching the query, best matches first, up to maxSearchResults at a time
+func (c *Service) SearchMessages(ctx context.Context, filter SearchFilter, count, offset int) ([]SearchResult, bool, error) {
+	log := c.log.With("query", filter.Query, "server_id", filter.ServerID, "channel_id", filter.ChannelID, "author_id", filter.AuthorID)
+
+	if filter.Query == "" {
+		return nil, false, ErrEmptySearchQuery
+	}
+	if count <= 0 || offset < 0 {
+		return nil, false, ErrInvalidCount
+	}
+	count = min(count, maxSearchResults)
+
+	q := c.db.NewSelect().
+		TableExpr("message").
+		Join("JOIN text_channel ON text_channel.id = message.channel_id").
+		ColumnExpr("message.id, text_channel.server_id").
+		ColumnExpr("ts_rank(message.search_vector, query) AS rank").
+		ColumnExpr("ts_headline(?, message.content, query, ?) AS snippet", searchConfig, headlineOptions).
+		Join("CROSS JOIN websearch_to_tsquery(?, ?) AS query", searchConfig, filter.Query).
+		Where("message.search_vector @@ query").
+		Where("message.deleted_at IS NULL").
+		Where(`(
+			EXISTS (SELECT 1 FROM server_member WHERE server_member.server_id = text_channel.server_id AND server_member.user_id = ?0)
+			OR EXISTS (SELECT 1 FROM channel_member WHERE channel_member.channel_id = message.channel_id AND channel_member.user_id = ?0)
+		)`, filter.UserID)
+
+	if filter.ServerID != uuid.Nil {
+		q = q.Where("text_channel.server_id = ?", filter.ServerID)
+	}
+	if filter.ChannelID != uuid.Nil {
+		q = q.Where("message.channel_id = ?", filter.ChannelID)
+	}
+	if filter.AuthorID != uuid.Nil {
+		q = q.Where("message.sender_id = ?", filter.AuthorID)
+	}
+	if !filter.From.IsZero() {
+		q = q.Where("message.timestamp >= ?", filter.From)
+	}
+	if !filter.To.IsZero() {
+		q = q.Where("message.timestamp < ?", filter.To)
+	}
+	if filter.HasAttachment != nil {
+		exists := "EXISTS (SELECT 1 FROM message_attachment WHERE message_attachment.message_id = message.id)"
+		if *filter.HasAttachment {
+			q = q.Where(exists)
+		} else {
+			q = q.Where("NOT " + exists)
+		}
+	}
+
+	var hits []searchHit
+	err := q.
+		OrderExpr("rank DESC, message.id DESC").
+		Limit(count+1).
+		Offset(offset).
+		Scan(ctx, &hits)
+	if err != nil {
+		log.Error("failed to search messages", "error", err)
+		return nil, false, err
+	}
+
+	hasMore := len(hits) > count
+	if hasMore {
+		hits = hits[:count]
+	}
+	if len(hits) == 0 {
+		return nil, false, nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(hits))
+	for _, hit := range hits {
+		ids = append(ids, hit.ID)
+	}
+
+	byID, err := c.getMessagesByIDs(ctx, ids)
+	if err != nil {
+		return nil, false, err
+	}
+
+	// Channels hidden from the user by permission overwrites are filtered out here, a page can come out shorter
+	visible := map[uuid.UUID]bool{}
+	results := make([]SearchResult, 0, len(hits))
+	for _, hit := range hits {
+		msg, ok := byID[hit.ID]
+		if !ok {
+			// deleted between the two queries
+			continue
+		}
+		if filter.UserID != uuid.Nil && hit.ServerID != nil {
+			canView, checked := visible[msg.ChannelID]
+			if !checked {
+				canView = c.hasPermission(ctx, filter.UserID, *hit.ServerID, msg.ChannelID, PermissionViewChannel)
+				visible[msg.ChannelID] = canView
+			}
+			if !canView {
+				continue
+			}
+		}
+		results = append(results, SearchResult{
+			ServerID: hit.ServerID,
+			Message:  msg,
+			Snippet:  highlightSnippet(hit.Snippet),
+			Rank:     hit.Rank,
+		})
+	}
+
+	return results, hasMore, nil
+}
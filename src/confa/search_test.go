@@ -0,0 +1,11 @@
+package confa
+
+import "testing"
+
+func TestHighlightSnippet(t *testing.T) {
+	snippet := "<script>" + headlineStartSel + "alert" + headlineStopSel + "(1)</script> & more"
+	expected := "&lt;script&gt;<mark>alert</mark>(1)&lt;/script&gt; &amp; more"
+	if got := highlightSnippet(snippet); got != expected {
+		t.Errorf("highlightSnippet() = %q, expected %q", got, expected)
+	}
+}
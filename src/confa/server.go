@@ -2,43 +2,93 @@ package confa
 
 import (
 	"context"
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
 
 	"github.com/confa-chat/node/pkg/uuid"
 	"github.com/confa-chat/node/src/store"
+	"github.com/uptrace/bun"
 )
 
-func (c *Service) CreateServer(ctx context.Context, name string) (uuid.UUID, error) {
-	log := c.log.With("name", name)
+var (
+	// ErrInvalidServerName is returned when a server name is empty or too long
+	ErrInvalidServerName = errors.New("invalid server name")
+	// ErrDefaultServer is returned when deleting the server new users join
+	ErrDefaultServer = errors.New("the default server can't be deleted")
+)
+
+// maxServerNameLength is the maximum length of a server name in characters
+const maxServerNameLength = 100
+
+// validServerName trims the name and reports whether it can be used for a server
+func validServerName(name string) (string, bool) {
+	name = strings.TrimSpace(name)
+	return name, name != "" && utf8.RuneCountInString(name) <= maxServerNameLength
+}
+
+// CreateServer creates a server owned by the user, who becomes its first member.
+// Servers created with a nil owner belong to the node and have no members.
+func (c *Service) CreateServer(ctx context.Context, ownerID uuid.UUID, name string) (uuid.UUID, error) {
+	log := c.log.With("name", name, "owner_id", ownerID)
+
+	name, ok := validServerName(name)
+	if !ok {
+		return uuid.Nil, ErrInvalidServerName
+	}
 
 	server := store.Server{
 		ID:   uuid.New(),
 		Name: name,
 	}
+	if ownerID != uuid.Nil {
+		server.OwnerID = &ownerID
+	}
+
+	everyone := store.Role{
+		ID:          server.ID,
+		ServerID:    server.ID,
+		Name:        everyoneRoleName,
+		Permissions: int64(DefaultPermissions),
+		CreatedAt:   time.Now(),
+	}
 
-	var idrow store.IDRow
-	_, err := c.db.NewInsert().Model(&server).Returning("id").Exec(ctx, &idrow)
+	err := c.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.NewInsert().Model(&server).Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewInsert().Model(&everyone).Exec(ctx); err != nil {
+			return err
+		}
+		if ownerID == uuid.Nil {
+			return nil
+		}
+		_, err := joinServer(ctx, tx, server.ID, ownerID)
+		return err
+	})
 	if err != nil {
 		log.Error("failed to create server", "error", err)
 		return uuid.Nil, err
 	}
 
-	return idrow.ID, err
+	return server.ID, nil
 }
 
-func (c *Service) GetServer(ctx context.Context, serverID uuid.UUID) ([]store.Server, error) {
-	log := c.log.With("serverID", serverID)
+func (c *Service) GetServer(ctx context.Context, serverID uuid.UUID) (store.Server, error) {
+	log := c.log.With("server_id", serverID)
 
-	var servers []store.Server
+	var server store.Server
 	err := c.db.NewSelect().
-		Model(&servers).
-		Where("server_id = ?", serverID).
+		Model(&server).
+		Where("id = ?", serverID).
 		Scan(ctx)
 	if err != nil {
 		log.Error("failed to get server", "error", err)
-		return nil, err
+		return server, err
 	}
 
-	return servers, err
+	return server, nil
 }
 
 func (c *Service) ListServers(ctx context.Context) ([]store.Server, error) {
@@ -56,3 +106,160 @@ func (c *Service) ListServers(ctx context.Context) ([]store.Server, error) {
 
 	return servers, err
 }
+
+// canDeleteServer reports whether the user can delete the server:
+// its owner, or a node moderator for servers owned by the node
+func (c *Service) canDeleteServer(server store.Server, userID uuid.UUID) bool {
+	if server.OwnerID != nil {
+		return *server.OwnerID == userID
+	}
+	return c.isNodeModerator(userID)
+}
+
+// RenameServer changes the name of the server, the user needs the permission to manage the server
+func (c *Service) RenameServer(ctx context.Context, userID, serverID uuid.UUID, name string) (store.Server, error) {
+	log := c.log.With("user_id", userID, "server_id", serverID, "name", name)
+
+	name, ok := validServerName(name)
+	if !ok {
+		return store.Server{}, ErrInvalidServerName
+	}
+
+	if err := c.CheckPermission(ctx, userID, serverID, uuid.Nil, PermissionManageServer); err != nil {
+		return store.Server{}, err
+	}
+
+	server, err := c.GetServer(ctx, serverID)
+	if err != nil {
+		return server, err
+	}
+
+	server.Name = name
+	_, err = c.db.NewUpdate().
+		Model(&server).
+		Column("name").
+		WherePK().
+		Exec(ctx)
+	if err != nil {
+		log.Error("failed to rename server", "error", err)
+		return server, err
+	}
+
+	return server, nil
+}
+
+// TransferServerOwnership makes another member of the server its owner, only the current owner can give it away
+func (c *Service) TransferServerOwnership(ctx context.Context, userID, serverID, newOwnerID uuid.UUID) (store.Server, error) {
+	log := c.log.With("user_id", userID, "server_id", serverID, "new_owner_id", newOwnerID)
+
+	var server store.Server
+	err := c.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		err := tx.NewSelect().
+			Model(&server).
+			Where("id = ?", serverID).
+			For("UPDATE").
+			Scan(ctx)
+		if err != nil {
+			return err
+		}
+		if server.OwnerID == nil || *server.OwnerID != userID {
+			return ErrPermissionDenied
+		}
+
+		isMember, err := tx.NewSelect().
+			Model((*store.ServerMember)(nil)).
+			Where("server_id = ?", serverID).
+			Where("user_id = ?", newOwnerID).
+			Exists(ctx)
+		if err != nil {
+			return err
+		}
+		if !isMember {
+			return ErrNotServerMember
+		}
+
+		server.OwnerID = &newOwnerID
+		_, err = tx.NewUpdate().
+			Model(&server).
+			Column("owner_id").
+			WherePK().
+			Exec(ctx)
+		return err
+	})
+	if errors.Is(err, ErrPermissionDenied) || errors.Is(err, ErrNotServerMember) {
+		return server, err
+	}
+	if err != nil {
+		log.Error("failed to transfer server ownership", "error", err)
+		return server, err
+	}
+
+	return server, nil
+}
+
+// DeleteServer deletes the server with its channels, messages and invites,
+// the blobs of its attachments and link previews are removed from the attachment storage.
+// The default server can't be deleted.
+func (c *Service) DeleteServer(ctx context.Context, userID, serverID uuid.UUID) error {
+	log := c.log.With("user_id", userID, "server_id", serverID)
+
+	if c.DefaultServerID != uuid.Nil && serverID == c.DefaultServerID {
+		return ErrDefaultServer
+	}
+
+	server, err := c.GetServer(ctx, serverID)
+	if err != nil {
+		return err
+	}
+	if !c.canDeleteServer(server, userID) {
+		return ErrPermissionDenied
+	}
+
+	start := time.Now()
+	var attachments []store.MessageAttachment
+	var previews []store.LinkPreview
+	err = c.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		// Everything else is removed by the cascade, the blobs are only known from these rows
+		messages := tx.NewSelect().
+			Model((*store.Message)(nil)).
+			Column("message.id").
+			Join("JOIN text_channel ON text_channel.id = message.channel_id").
+			Where("text_channel.server_id = ?", serverID)
+
+		_, err := tx.NewDelete().
+			Model(&attachments).
+			Where("message_id IN (?)", messages).
+			Returning("*").
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.NewDelete().
+			Model(&previews).
+			Where("message_id IN (?)", messages).
+			Returning("*").
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.NewDelete().
+			Model(&server).
+			WherePK().
+			Exec(ctx)
+		return err
+	})
+	if err != nil {
+		log.Error("failed to delete server", "error", err)
+		return err
+	}
+
+	c.members.evict(serverID, uuid.Nil)
+	c.deleteUnreferencedAttachments(ctx, attachments)
+	c.deleteThumbnails(ctx, previews)
+
+	log.Info("server deleted", "attachments", len(attachments), "previews", len(previews), "duration", time.Since(start))
+
+	return nil
+}
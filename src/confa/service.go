@@ -3,8 +3,10 @@ package confa
 import (
 	"log/slog"
 
+	"github.com/confa-chat/node/pkg/unfurl"
 	"github.com/confa-chat/node/pkg/uuid"
 	"github.com/confa-chat/node/src/config"
+	chatv1 "github.com/confa-chat/node/src/proto/confa/chat/v1"
 	"github.com/confa-chat/node/src/store/attachment"
 	"github.com/cskr/pubsub/v2"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -12,22 +14,45 @@ import (
 )
 
 type Service struct {
-	db            *bun.DB
-	dbpool        *pgxpool.Pool
-	msgBroker     *pubsub.PubSub[uuid.UUID, uuid.UUID]
-	Config        *config.Config
-	attachStorage attachment.Storage
+	db              *bun.DB
+	dbpool          *pgxpool.Pool
+	msgBroker       *pubsub.PubSub[uuid.UUID, *chatv1.MessageEvent]
+	mentionBroker   *pubsub.PubSub[uuid.UUID, *chatv1.Mention]
+	readStateBroker *pubsub.PubSub[uuid.UUID, *chatv1.ReadState]
+	typing          *typingTracker
+	members         *memberWatches
+	unfurler        *unfurl.Fetcher
+	unfurlSlots     chan struct{}
+	Config          *config.Config
+	// DefaultServerID is the server new users join on their first login, it can't be deleted
+	DefaultServerID uuid.UUID
+	attachStorage   attachment.Storage
 
 	log *slog.Logger
 }
 
 func NewService(db *bun.DB, dbpool *pgxpool.Pool, cfg *config.Config, attachStorage attachment.Storage) *Service {
+	var unfurler *unfurl.Fetcher
+	if !cfg.Unfurl.Disabled {
+		unfurler = unfurl.NewFetcher(unfurl.Options{
+			Timeout:     cfg.Unfurl.Timeout,
+			MaxBodySize: cfg.Unfurl.MaxBodySize,
+			CacheTTL:    cfg.Unfurl.CacheTTL,
+		})
+	}
+
 	return &Service{
-		db:            db,
-		dbpool:        dbpool,
-		msgBroker:     pubsub.New[uuid.UUID, uuid.UUID](10),
-		Config:        cfg,
-		attachStorage: attachStorage,
+		db:              db,
+		dbpool:          dbpool,
+		msgBroker:       pubsub.New[uuid.UUID, *chatv1.MessageEvent](10),
+		mentionBroker:   pubsub.New[uuid.UUID, *chatv1.Mention](10),
+		readStateBroker: pubsub.New[uuid.UUID, *chatv1.ReadState](10),
+		typing:          newTypingTracker(),
+		members:         newMemberWatches(),
+		unfurler:        unfurler,
+		unfurlSlots:     make(chan struct{}, cfg.Unfurl.MaxConcurrent),
+		Config:          cfg,
+		attachStorage:   attachStorage,
 
 		log: slog.Default().With(slog.String("service", "confa")),
 	}
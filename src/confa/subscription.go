@@ -0,0 +1,323 @@
+package confa
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/confa-chat/node/pkg/uuid"
+	chatv1 "github.com/confa-chat/node/src/proto/confa/chat/v1"
+	"github.com/confa-chat/node/src/store"
+	"github.com/cskr/pubsub/v2"
+)
+
+const (
+	// replayBatchSize is the number of messages loaded per query when replaying missed messages
+	replayBatchSize = 100
+	// replayOverlap is how long before the last replayed message the replay is repeated after subscribing,
+	// messages whose transaction took longer than that to commit can be missed
+	replayOverlap = 30 * time.Second
+)
+
+type ChannelSubscription struct {
+	ChannelID uuid.UUID
+	// ThreadID is the parent message for thread subscriptions
+	ThreadID uuid.UUID
+	// Events is closed when the subscription ends, check Err for the reason
+	Events chan *chatv1.MessageEvent
+
+	msgBroker *pubsub.PubSub[uuid.UUID, *chatv1.MessageEvent]
+
+	// ctx is cancelled when the user loses access to the channel
+	ctx     context.Context
+	release func()
+
+	// set only for subscriptions that replay missed messages
+	cancel context.CancelFunc
+	done   chan struct{}
+	err    error
+}
+
+func (c *ChannelSubscription) Close() {
+	if c.release != nil {
+		defer c.release()
+	}
+
+	if c.cancel != nil {
+		c.cancel()
+		<-c.done
+		return
+	}
+
+	c.msgBroker.Unsub(c.Events, c.topic())
+	// Drain the channel
+	for range c.Events {
+	}
+}
+
+// topic returns the broker topic the subscription listens to
+func (c *ChannelSubscription) topic() uuid.UUID {
+	if c.ThreadID != uuid.Nil {
+		return c.ThreadID
+	}
+	return c.ChannelID
+}
+
+// Err returns the error that ended the subscription, if any.
+// It is ErrRemovedFromServer when the user left or was removed from the server of the channel,
+// ErrPermissionDenied when they can't view the channel anymore.
+func (c *ChannelSubscription) Err() error {
+	if c.ctx != nil {
+		if cause := context.Cause(c.ctx); errors.Is(cause, ErrRemovedFromServer) || errors.Is(cause, ErrPermissionDenied) {
+			return cause
+		}
+	}
+	if c.done == nil {
+		return nil
+	}
+	select {
+	case <-c.done:
+		return c.err
+	default:
+		return nil
+	}
+}
+
+// SubscribeNewMessages subscribes to the events of a channel.
+// If lastSeenID is set, messages sent after it are replayed from the database first,
+// then the subscription switches to live events without gaps or duplicates.
+// The user needs the permission to view the channel, the subscription ends when they lose it.
+func (c *Service) SubscribeNewMessages(ctx context.Context, userID, channelID, lastSeenID uuid.UUID) (*ChannelSubscription, error) {
+	return c.subscribe(ctx, userID, channelID, uuid.Nil, lastSeenID)
+}
+
+// SubscribeThreadMessages subscribes to the replies to a message, see SubscribeNewMessages.
+// The parent must be a top-level message of the channel, sql.ErrNoRows is returned otherwise.
+func (c *Service) SubscribeThreadMessages(ctx context.Context, userID, channelID, parentID, lastSeenID uuid.UUID) (*ChannelSubscription, error) {
+	return c.subscribe(ctx, userID, channelID, parentID, lastSeenID)
+}
+
+func (c *Service) subscribe(ctx context.Context, userID, channelID, threadID, lastSeenID uuid.UUID) (*ChannelSubscription, error) {
+	var channel store.TextChannel
+	err := c.db.NewSelect().
+		Model(&channel).
+		Column("id", "server_id").
+		Where("id = ?", channelID).
+		Scan(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrPermissionDenied
+	}
+	if err != nil {
+		c.log.Error("failed to get channel", "channel_id", channelID, "error", err)
+		return nil, err
+	}
+
+	sub := &ChannelSubscription{
+		ChannelID: channelID,
+		ThreadID:  threadID,
+		msgBroker: c.msgBroker,
+	}
+	// Channels outside of servers are watched by themselves, so removing a group member ends their subscription
+	scopeID := channelID
+	if channel.ServerID != nil {
+		scopeID = *channel.ServerID
+	}
+	// Watch before checking the permission, so a removal right after the check isn't missed
+	ctx, sub.release = c.members.watch(ctx, scopeID, userID, channelID)
+	sub.ctx = ctx
+	if err := c.CheckPermission(ctx, userID, uuid.Nil, channelID, PermissionViewChannel); err != nil {
+		sub.release()
+		return nil, err
+	}
+	if threadID != uuid.Nil {
+		// The topic is the parent alone, so it must be checked to belong to the channel the user can view
+		exists, err := c.db.NewSelect().
+			Model((*store.Message)(nil)).
+			Where("id = ?", threadID).
+			Where("channel_id = ?", channelID).
+			Where("parent_id IS NULL").
+			Exists(ctx)
+		if err != nil {
+			sub.release()
+			c.log.Error("failed to check thread parent", "channel_id", channelID, "parent_id", threadID, "error", err)
+			return nil, err
+		}
+		if !exists {
+			sub.release()
+			return nil, sql.ErrNoRows
+		}
+	}
+
+	if lastSeenID == uuid.Nil {
+		sub.Events = c.msgBroker.Sub(sub.topic())
+		go func() {
+			<-sub.ctx.Done()
+			// Closes Events when the user lost access, unsubscribing again in Close does nothing
+			if sub.Err() != nil {
+				c.msgBroker.Unsub(sub.Events, sub.topic())
+			}
+		}()
+
+		return sub, nil
+	}
+
+	ctx, sub.cancel = context.WithCancel(ctx)
+	sub.Events = make(chan *chatv1.MessageEvent)
+	sub.done = make(chan struct{})
+
+	go func() {
+		defer close(sub.done)
+		defer close(sub.Events)
+		sub.err = c.resumeSubscription(ctx, sub, lastSeenID)
+	}()
+
+	return sub, nil
+}
+
+// resumeSubscription replays the messages sent after lastSeenID, then forwards live events.
+//
+// Message IDs are generated before their transaction commits, so a message can become visible after
+// messages with higher IDs were replayed. The replay after subscribing to live events starts replayOverlap
+// before the last replayed message to pick such messages up. Only the IDs replayed within that window
+// are remembered to drop their live duplicates.
+func (c *Service) resumeSubscription(ctx context.Context, sub *ChannelSubscription, lastSeenID uuid.UUID) error {
+	// IDs of recently replayed messages, a message can be replayed and still be published
+	// after we subscribe when it was committed right before the subscription
+	replayed := map[uuid.UUID]struct{}{}
+	lastReplayedID := lastSeenID
+
+	send := func(event *chatv1.MessageEvent) bool {
+		select {
+		case sub.Events <- event:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	replay := func(afterID uuid.UUID) error {
+		for {
+			msgs, err := c.getMessagesAfter(ctx, sub.ChannelID, sub.ThreadID, afterID, replayBatchSize)
+			if err != nil {
+				return err
+			}
+
+			for _, msg := range msgs {
+				afterID = msg.ID
+				if _, ok := replayed[msg.ID]; ok {
+					continue
+				}
+				replayed[msg.ID] = struct{}{}
+				if bytes.Compare(msg.ID.Bytes(), lastReplayedID.Bytes()) > 0 {
+					lastReplayedID = msg.ID
+				}
+
+				if !send(replayEvent(msg)) {
+					return nil
+				}
+			}
+
+			// Forget messages too old to have a duplicate
+			floor := idFloor(lastReplayedID, replayOverlap)
+			for id := range replayed {
+				if bytes.Compare(id.Bytes(), floor.Bytes()) < 0 {
+					delete(replayed, id)
+				}
+			}
+
+			if len(msgs) < replayBatchSize {
+				return nil
+			}
+		}
+	}
+
+	// Replay the bulk of missed messages before subscribing, so a slow
+	// client doesn't hold back the broker
+	if err := replay(lastSeenID); err != nil {
+		return err
+	}
+
+	live := c.msgBroker.Sub(sub.topic())
+	defer func() {
+		c.msgBroker.Unsub(live, sub.topic())
+		for range live {
+		}
+	}()
+
+	// Catch up with messages committed while the first replay was running, including late commits of lower IDs
+	floor := idFloor(lastReplayedID, replayOverlap)
+	if bytes.Compare(floor.Bytes(), lastSeenID.Bytes()) < 0 {
+		floor = lastSeenID
+	}
+	if err := replay(floor); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event := <-live:
+			if created := event.GetCreated(); created != nil {
+				id, err := uuid.FromString(created.GetMessage().GetMessageId())
+				if err == nil {
+					if _, ok := replayed[id]; ok {
+						delete(replayed, id)
+						continue
+					}
+				}
+			}
+
+			if !send(event) {
+				return nil
+			}
+		}
+	}
+}
+
+// idFloor returns the smallest UUIDv7 which could have been generated d before the given one
+func idFloor(id uuid.UUID, d time.Duration) uuid.UUID {
+	// The first 48 bits of a UUIDv7 are its Unix timestamp in milliseconds
+	var ms uint64
+	for _, b := range id.Bytes()[:6] {
+		ms = ms<<8 | uint64(b)
+	}
+	ms -= min(ms, uint64(d.Milliseconds()))
+
+	var floor uuid.UUID
+	for i := 5; i >= 0; i-- {
+		floor.UUID[i] = byte(ms)
+		ms >>= 8
+	}
+	return floor
+}
+
+// getMessagesAfter returns messages of the channel, or of a thread when threadID is set,
+// sent after the given message in sending order
+func (c *Service) getMessagesAfter(ctx context.Context, channelID, threadID, afterID uuid.UUID, count int) ([]store.Message, error) {
+	var messages []store.Message
+	q := c.db.NewSelect().
+		Model(&messages).
+		Where("channel_id = ?", channelID).
+		Where("id > ?", afterID)
+	if threadID != uuid.Nil {
+		q = q.Where("parent_id = ?", threadID)
+	} else {
+		q = q.Where("parent_id IS NULL")
+	}
+	err := q.
+		Order("id ASC").
+		Relation("Attachments").
+		Relation("Previews").
+		Limit(count).
+		Scan(ctx)
+
+	if err != nil {
+		c.log.Error("failed to get messages after", "channel_id", channelID, "after_id", afterID, "count", count, "error", err)
+		return nil, err
+	}
+
+	return messages, nil
+}
@@ -0,0 +1,24 @@
+package confa
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/confa-chat/node/pkg/uuid"
+)
+
+func TestIDFloor(t *testing.T) {
+	now := time.Now()
+	floor := idFloor(uuid.NewFromTime(now), replayOverlap)
+
+	if inWindow := uuid.NewFromTime(now.Add(-replayOverlap)); bytes.Compare(floor.Bytes(), inWindow.Bytes()) > 0 {
+		t.Errorf("idFloor() = %s, expected at most %s", floor, inWindow)
+	}
+	if beforeWindow := uuid.NewFromTime(now.Add(-replayOverlap - time.Second)); bytes.Compare(floor.Bytes(), beforeWindow.Bytes()) <= 0 {
+		t.Errorf("idFloor() = %s, expected more than %s", floor, beforeWindow)
+	}
+	if floor := idFloor(uuid.Nil, replayOverlap); floor != uuid.Nil {
+		t.Errorf("idFloor(Nil) = %s, expected Nil", floor)
+	}
+}
@@ -0,0 +1,81 @@
+package confa
+
+import (
+	"context"
+	"errors"
+
+	"github.com/confa-chat/node/pkg/uuid"
+	"github.com/confa-chat/node/src/store"
+	"github.com/uptrace/bun"
+)
+
+// ErrInvalidReplyTarget is returned when replying to a message that can't have a thread
+var ErrInvalidReplyTarget = errors.New("message can't be replied to")
+
+// addThreadReply checks that a reply can be posted to the parent message and bumps its reply counter.
+// Threads are one level deep, so replies themselves can't be replied to.
+func (c *Service) addThreadReply(ctx context.Context, tx bun.Tx, channelID, parentID uuid.UUID) error {
+	var parent store.Message
+	err := tx.NewSelect().
+		Model(&parent).
+		Where("id = ?", parentID).
+		For("UPDATE").
+		Scan(ctx)
+	if err != nil {
+		return err
+	}
+
+	if parent.ChannelID != channelID || parent.ParentID != nil || parent.DeletedAt != nil {
+		return ErrInvalidReplyTarget
+	}
+
+	_, err = tx.NewUpdate().
+		Model((*store.Message)(nil)).
+		Set("reply_count = reply_count + 1").
+		Where("id = ?", parentID).
+		Exec(ctx)
+	return err
+}
+
+// publishThreadUpdated lets channel subscribers know that the thread of a message changed
+func (c *Service) publishThreadUpdated(ctx context.Context, serverID, channelID, parentID uuid.UUID) {
+	parent, err := c.GetMessage(ctx, serverID, channelID, parentID)
+	if err != nil {
+		return
+	}
+
+	c.publishEvent(channelID, newThreadUpdatedEvent(parent))
+}
+
+// ListThreadMessages returns replies to a message sent after the given reply, oldest first.
+// count must be positive and is capped at maxHistoryPageSize.
+func (c *Service) ListThreadMessages(ctx context.Context, serverID, channelID, parentID, afterID uuid.UUID, count int) ([]store.Message, bool, error) {
+	if count <= 0 {
+		return nil, false, ErrInvalidCount
+	}
+	count = min(count, maxHistoryPageSize)
+
+	var messages []store.Message
+	err := c.db.NewSelect().
+		Model(&messages).
+		Where("channel_id = ?", channelID).
+		Where("parent_id = ?", parentID).
+		Where("id > ?", afterID).
+		Order("id ASC").
+		Relation("Attachments").
+		Relation("Previews").
+		Limit(count + 1).
+		Scan(ctx)
+
+	if err != nil {
+		c.log.Error("failed to list thread messages", "server_id", serverID, "channel_id", channelID, "parent_id", parentID, "after_id", afterID, "count", count, "error", err)
+		return nil, false, err
+	}
+
+	hasMore := len(messages) > count
+	if hasMore {
+		messages = messages[:count]
+	}
+
+	return messages, hasMore, nil
+}
@@ -0,0 +1,133 @@
+package confa
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/confa-chat/node/pkg/uuid"
+	chatv1 "github.com/confa-chat/node/src/proto/confa/chat/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// typingTimeout is how long a typing indicator lasts without being refreshed
+	typingTimeout = 8 * time.Second
+	// typingThrottle is the minimum interval between typing starts of a user
+	typingThrottle = time.Second
+)
+
+// ErrTypingThrottled is returned when a user starts typing too often
+var ErrTypingThrottled = errors.New("typing notifications are sent too often")
+
+type typingKey struct {
+	ChannelID uuid.UUID
+	UserID    uuid.UUID
+}
+
+// typingTracker keeps ephemeral typing indicators in memory, they are never persisted
+type typingTracker struct {
+	mu        sync.Mutex
+	active    map[typingKey]*time.Timer
+	lastStart map[uuid.UUID]time.Time
+}
+
+func newTypingTracker() *typingTracker {
+	return &typingTracker{
+		active:    map[typingKey]*time.Timer{},
+		lastStart: map[uuid.UUID]time.Time{},
+	}
+}
+
+// SetTyping starts or stops the typing indicator of the user in the channel.
+// Repeating a start only extends the indicator, a stop is sent automatically after typingTimeout.
+func (c *Service) SetTyping(ctx context.Context, userID, serverID, channelID uuid.UUID, typing bool) error {
+	t := c.typing
+	key := typingKey{ChannelID: channelID, UserID: userID}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	timer, active := t.active[key]
+
+	if !typing {
+		if active {
+			timer.Stop()
+			delete(t.active, key)
+			c.publishEvent(channelID, newTypingEvent(userID, false, time.Time{}))
+		}
+		return nil
+	}
+
+	if active {
+		timer.Reset(typingTimeout)
+		return nil
+	}
+
+	now := time.Now()
+	if now.Sub(t.lastStart[userID]) < typingThrottle {
+		return ErrTypingThrottled
+	}
+	t.lastStart[userID] = now
+	t.pruneLastStart(now)
+
+	var expire *time.Timer
+	expire = time.AfterFunc(typingTimeout, func() {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+
+		// The indicator could have been stopped and started again meanwhile
+		if t.active[key] != expire {
+			return
+		}
+		delete(t.active, key)
+		c.publishEvent(channelID, newTypingEvent(userID, false, time.Time{}))
+	})
+	t.active[key] = expire
+
+	c.publishEvent(channelID, newTypingEvent(userID, true, now.Add(typingTimeout)))
+
+	return nil
+}
+
+// clearTyping stops the typing indicator of a user who just sent a message to the channel
+func (c *Service) clearTyping(userID, channelID uuid.UUID) {
+	t := c.typing
+	key := typingKey{ChannelID: channelID, UserID: userID}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if timer, ok := t.active[key]; ok {
+		timer.Stop()
+		delete(t.active, key)
+		c.publishEvent(channelID, newTypingEvent(userID, false, time.Time{}))
+	}
+}
+
+// pruneLastStart forgets users who can't be throttled anymore, must be called with the lock held
+func (t *typingTracker) pruneLastStart(now time.Time) {
+	if len(t.lastStart) < 1024 {
+		return
+	}
+	for userID, start := range t.lastStart {
+		if now.Sub(start) >= typingThrottle {
+			delete(t.lastStart, userID)
+		}
+	}
+}
+
+func newTypingEvent(userID uuid.UUID, typing bool, expiresAt time.Time) *chatv1.MessageEvent {
+	event := &chatv1.TypingEvent{
+		UserId: userID.String(),
+		Typing: typing,
+	}
+	if !expiresAt.IsZero() {
+		event.ExpiresAt = timestamppb.New(expiresAt)
+	}
+
+	return &chatv1.MessageEvent{
+		Event: &chatv1.MessageEvent_Typing{Typing: event},
+	}
+}
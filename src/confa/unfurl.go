@@ -0,0 +1,192 @@
+package confa
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"mime"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/confa-chat/node/pkg/markdown"
+	"github.com/confa-chat/node/pkg/uuid"
+	"github.com/confa-chat/node/src/store"
+	"github.com/uptrace/bun"
+)
+
+// unfurlTimeout limits fetching all previews of a message
+const unfurlTimeout = time.Minute
+
+// errStalePreviews is returned when the message changed while its previews were fetched
+var errStalePreviews = errors.New("message changed while fetching previews")
+
+// messageLinks returns up to max distinct web links of the message, in order of appearance
+func messageLinks(nodes []markdown.Node, max int) []string {
+	var links []string
+	var walk func(nodes []markdown.Node)
+	walk = func(nodes []markdown.Node) {
+		for _, node := range nodes {
+			if len(links) >= max {
+				return
+			}
+			isWeb := strings.HasPrefix(node.URL, "http://") || strings.HasPrefix(node.URL, "https://")
+			if node.Type == markdown.NodeLink && isWeb && !slices.Contains(links, node.URL) {
+				links = append(links, node.URL)
+			}
+			walk(node.Children)
+		}
+	}
+	walk(nodes)
+	return links
+}
+
+// unfurlMessage fetches previews of the links in the message in the background,
+// they are stored and published as an edit of the message once fetched.
+// At most Unfurl.MaxConcurrent messages are unfurled at once, the message is
+// skipped when all slots are busy
+func (c *Service) unfurlMessage(msg store.Message) {
+	if c.unfurler == nil {
+		return
+	}
+
+	links := messageLinks(msg.ContentNodes, c.Config.Unfurl.MaxLinks)
+	if len(links) == 0 && len(msg.Previews) == 0 {
+		return
+	}
+
+	select {
+	case c.unfurlSlots <- struct{}{}:
+	default:
+		c.log.Warn("too many link previews being fetched, skipping message",
+			"channel_id", msg.ChannelID, "message_id", msg.ID)
+		return
+	}
+
+	go func() {
+		defer func() { <-c.unfurlSlots }()
+		c.updatePreviews(msg, links)
+	}()
+}
+
+func (c *Service) updatePreviews(msg store.Message, links []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), unfurlTimeout)
+	defer cancel()
+
+	log := c.log.With("channel_id", msg.ChannelID, "message_id", msg.ID)
+
+	previews := make([]store.LinkPreview, 0, len(links))
+	for _, link := range links {
+		p, err := c.unfurler.Fetch(ctx, link)
+		if err != nil {
+			log.Debug("failed to fetch link preview", "url", link, "error", err)
+			continue
+		}
+
+		preview := store.LinkPreview{
+			ID:          uuid.New(),
+			MessageID:   msg.ID,
+			Position:    len(previews),
+			URL:         link,
+			Title:       p.Title,
+			Description: p.Description,
+			SiteName:    p.SiteName,
+		}
+		if p.ImageURL != "" {
+			if err := c.uploadThumbnail(ctx, &preview, p.ImageURL); err != nil {
+				log.Debug("failed to fetch link preview thumbnail", "url", p.ImageURL, "error", err)
+			}
+		}
+		previews = append(previews, preview)
+	}
+
+	var old []store.LinkPreview
+	err := c.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		var current store.Message
+		err := tx.NewSelect().
+			Model(&current).
+			Column("content", "deleted_at").
+			Where("id = ?", msg.ID).
+			For("UPDATE").
+			Scan(ctx)
+		if err != nil {
+			return err
+		}
+		// A newer edit unfurls its own links, and deleted messages have no previews
+		if current.DeletedAt != nil || current.Content != msg.Content {
+			return errStalePreviews
+		}
+
+		_, err = tx.NewDelete().
+			Model(&old).
+			Where("message_id = ?", msg.ID).
+			Returning("*").
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		if len(previews) == 0 {
+			return nil
+		}
+		_, err = tx.NewInsert().Model(&previews).Exec(ctx)
+		return err
+	})
+	if err != nil {
+		c.deleteThumbnails(ctx, previews)
+		if !errors.Is(err, errStalePreviews) {
+			log.Error("failed to store link previews", "error", err)
+		}
+		return
+	}
+	c.deleteThumbnails(ctx, old)
+
+	if len(old) == 0 && len(previews) == 0 {
+		return
+	}
+
+	updated, err := c.GetMessage(ctx, uuid.Nil, msg.ChannelID, msg.ID)
+	if err != nil {
+		return
+	}
+	msgs := []store.Message{updated}
+	if err := c.LoadReactions(ctx, uuid.Nil, msgs); err != nil {
+		return
+	}
+
+	c.publishMessageEdited(msgs[0])
+}
+
+// uploadThumbnail stores the image of the preview in the attachment storage
+func (c *Service) uploadThumbnail(ctx context.Context, preview *store.LinkPreview, imageURL string) error {
+	img, err := c.unfurler.FetchImage(ctx, imageURL)
+	if err != nil {
+		return err
+	}
+
+	name := "thumbnail"
+	if exts, _ := mime.ExtensionsByType(img.ContentType); len(exts) > 0 {
+		name += exts[0]
+	}
+
+	info, err := c.attachStorage.Upload(ctx, name, bytes.NewReader(img.Data))
+	if err != nil {
+		return err
+	}
+
+	preview.ThumbnailID = &info.ID
+	preview.ThumbnailName = info.Filename
+	return nil
+}
+
+// deleteThumbnails removes thumbnails of the previews from the attachment storage
+func (c *Service) deleteThumbnails(ctx context.Context, previews []store.LinkPreview) {
+	for _, p := range previews {
+		if p.ThumbnailID == nil {
+			continue
+		}
+		if err := c.attachStorage.Delete(ctx, *p.ThumbnailID); err != nil {
+			c.log.Error("failed to delete link preview thumbnail", "attachment_id", *p.ThumbnailID, "error", err)
+		}
+	}
+}
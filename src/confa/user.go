@@ -30,6 +30,8 @@ func (u *Service) ListServerUser(ctx context.Context, serverID uuid.UUID) ([]sto
 	var users []store.User
 	err := u.db.NewSelect().
 		Model(&users).
+		Join("JOIN server_member ON server_member.user_id = \"user\".id").
+		Where("server_member.server_id = ?", serverID).
 		Scan(ctx)
 
 	if err != nil {
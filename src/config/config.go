@@ -6,6 +6,7 @@ import (
 	"log"
 	"strconv"
 	"strings"
+	"time"
 
 	nodev1 "github.com/confa-chat/node/src/proto/confa/node/v1"
 	"github.com/knadh/koanf/parsers/yaml"
@@ -63,12 +64,42 @@ type AttachmentStorage struct {
 	} `koanf:"s3"`
 }
 
+// Chat represents limits of the chat features
+type Chat struct {
+	// MaxPinsPerChannel is the maximum number of pinned messages in a channel
+	MaxPinsPerChannel int `koanf:"maxpinsperchannel"`
+	// MaxGroupMembers is the maximum number of members of a group conversation, including its owner
+	MaxGroupMembers int `koanf:"maxgroupmembers"`
+}
+
+// Unfurl represents configuration of link previews
+type Unfurl struct {
+	// Disabled turns off fetching previews of links in messages
+	Disabled bool `koanf:"disabled"`
+	// Timeout limits fetching a single page or thumbnail
+	Timeout time.Duration `koanf:"timeout"`
+	// MaxBodySize is the maximum number of bytes read from a page or a thumbnail
+	MaxBodySize int64 `koanf:"maxbodysize"`
+	// MaxLinks is the maximum number of previews of a message
+	MaxLinks int `koanf:"maxlinks"`
+	// CacheTTL is how long fetched previews are reused
+	CacheTTL time.Duration `koanf:"cachettl"`
+	// MaxConcurrent limits how many messages are unfurled at the same time,
+	// links of messages sent while all slots are busy are not previewed
+	MaxConcurrent int `koanf:"maxconcurrent"`
+}
+
 // Config represents the application configuration
 type Config struct {
 	DB               string            `koanf:"db"`
 	AuthProviders    []AuthProvider    `koanf:"authproviders"`
 	VoiceRelays      []VoiceRelay      `koanf:"voicerelays"`
 	AttachmentConfig AttachmentStorage `koanf:"attachment"`
+	// Moderators is a list of user IDs who can moderate messages on the servers they joined
+	// and delete the servers owned by the node
+	Moderators []string `koanf:"moderators"`
+	Chat       Chat     `koanf:"chat"`
+	Unfurl     Unfurl   `koanf:"unfurl"`
 }
 
 // Load loads configuration from YAML file and environment variables
@@ -104,6 +135,11 @@ func Load(configFile string) (*Config, error) {
 		log.Printf("warning failed to parse voice relays: %v", err)
 	}
 
+	err = parseMapToSlice(k, "moderators")
+	if err != nil {
+		log.Printf("warning failed to parse moderators: %v", err)
+	}
+
 	var cfg Config
 	// Unmarshal the config
 	if err := k.Unmarshal("", &cfg); err != nil {
@@ -192,6 +228,29 @@ func validateConfig(cfg *Config) error {
 		}
 	}
 
+	if cfg.Chat.MaxPinsPerChannel <= 0 {
+		cfg.Chat.MaxPinsPerChannel = 50
+	}
+	if cfg.Chat.MaxGroupMembers <= 0 {
+		cfg.Chat.MaxGroupMembers = 10
+	}
+
+	if cfg.Unfurl.Timeout <= 0 {
+		cfg.Unfurl.Timeout = 5 * time.Second
+	}
+	if cfg.Unfurl.MaxBodySize <= 0 {
+		cfg.Unfurl.MaxBodySize = 2 << 20
+	}
+	if cfg.Unfurl.MaxLinks <= 0 {
+		cfg.Unfurl.MaxLinks = 5
+	}
+	if cfg.Unfurl.CacheTTL <= 0 {
+		cfg.Unfurl.CacheTTL = time.Hour
+	}
+	if cfg.Unfurl.MaxConcurrent <= 0 {
+		cfg.Unfurl.MaxConcurrent = 16
+	}
+
 	switch cfg.AttachmentConfig.Type {
 	case "local":
 		if cfg.AttachmentConfig.Local.Path == "" {
@@ -2,6 +2,8 @@ package proto
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 	"io"
 
@@ -9,6 +11,7 @@ import (
 	"github.com/confa-chat/node/src/auth"
 	"github.com/confa-chat/node/src/confa"
 	chatv1 "github.com/confa-chat/node/src/proto/confa/chat/v1"
+	"github.com/confa-chat/node/src/store"
 	"github.com/confa-chat/node/src/store/attachment"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -39,55 +42,66 @@ func (c *ChatService) SendMessage(ctx context.Context, req *chatv1.SendMessageRe
 	if err != nil {
 		return nil, err
 	}
+	if err := c.srv.CheckPermission(ctx, user.ID, ref.ServerID, ref.ChannelID, confa.PermissionSendMessages); err != nil {
+		return nil, mapMessageError(err)
+	}
 
-	var attachmentIDs []uuid.UUID
-	var attachmentNames []string
-
-	// Process attachments if any
-	if len(req.AttachmentIds) > 0 {
-		// Convert string IDs to UUID
-		attachmentIDs = make([]uuid.UUID, len(req.AttachmentIds))
-		attachmentNames = make([]string, len(req.AttachmentIds))
-
-		for i, idStr := range req.AttachmentIds {
-			id, err := uuid.FromString(idStr)
-			if err != nil {
-				return nil, status.Errorf(codes.InvalidArgument, "invalid attachment ID: %v", err)
-			}
-			attachmentIDs[i] = id
-
-			// Get attachment info to retrieve the original filename
-			info, err := c.srv.GetAttachmentInfo(ctx, id)
-			if err != nil {
-				return nil, status.Errorf(codes.NotFound, "attachment not found: %v", err)
-			}
-			attachmentNames[i] = info.Filename
-		}
-
-		// Send message with attachments
-		id, err := c.srv.SendMessageWithAttachments(ctx, user.ID, ref.ServerID, ref.ChannelID, req.Content, attachmentIDs, attachmentNames)
+	replyToID := uuid.Nil
+	if req.ReplyToMessageId != "" {
+		replyToID, err = uuid.FromString(req.ReplyToMessageId)
 		if err != nil {
-			return nil, err
+			return nil, status.Errorf(codes.InvalidArgument, "invalid reply message ID: %v", err)
 		}
-
-		return &chatv1.SendMessageResponse{MessageId: id.String()}, nil
 	}
 
-	// Regular message without attachments
-	id, err := c.srv.SendMessage(ctx, user.ID, ref.ServerID, ref.ChannelID, req.Content)
+	attachmentIDs, attachmentNames, err := c.resolveAttachments(ctx, req.AttachmentIds)
 	if err != nil {
 		return nil, err
 	}
 
+	id, err := c.srv.SendMessageWithAttachments(ctx, user.ID, ref.ServerID, ref.ChannelID, replyToID, req.Content, attachmentIDs, attachmentNames, req.Ttl.AsDuration())
+	if err != nil {
+		return nil, mapMessageError(err)
+	}
+
 	return &chatv1.SendMessageResponse{MessageId: id.String()}, nil
 }
 
+// resolveAttachments parses attachment IDs and retrieves their original filenames
+func (c *ChatService) resolveAttachments(ctx context.Context, ids []string) ([]uuid.UUID, []string, error) {
+	attachmentIDs := make([]uuid.UUID, len(ids))
+	attachmentNames := make([]string, len(ids))
+
+	for i, idStr := range ids {
+		id, err := uuid.FromString(idStr)
+		if err != nil {
+			return nil, nil, status.Errorf(codes.InvalidArgument, "invalid attachment ID: %v", err)
+		}
+		attachmentIDs[i] = id
+
+		info, err := c.srv.GetAttachmentInfo(ctx, id)
+		if err != nil {
+			return nil, nil, status.Errorf(codes.NotFound, "attachment not found: %v", err)
+		}
+		attachmentNames[i] = info.Filename
+	}
+
+	return attachmentIDs, attachmentNames, nil
+}
+
 // GetMessage implements chatv1.ChatServiceServer.
 func (c *ChatService) GetMessage(ctx context.Context, req *chatv1.GetMessageRequest) (*chatv1.GetMessageResponse, error) {
+	user := auth.CtxGetUser(ctx)
+	if user == nil {
+		return nil, ErrUnauthenticated
+	}
 	ref, err := parseChannelRef(req.Channel)
 	if err != nil {
 		return nil, err
 	}
+	if err := c.srv.CheckPermission(ctx, user.ID, ref.ServerID, ref.ChannelID, confa.PermissionViewChannel); err != nil {
+		return nil, mapMessageError(err)
+	}
 
 	messageID, err := uuid.FromString(req.MessageId)
 	if err != nil {
@@ -96,51 +110,124 @@ func (c *ChatService) GetMessage(ctx context.Context, req *chatv1.GetMessageRequ
 
 	msg, err := c.srv.GetMessage(ctx, ref.ServerID, ref.ChannelID, messageID)
 	if err != nil {
+		return nil, mapMessageError(err)
+	}
+
+	msgs := []store.Message{msg}
+	if err := c.srv.LoadReactions(ctx, user.ID, msgs); err != nil {
 		return nil, err
 	}
 
 	return &chatv1.GetMessageResponse{
-		Message: mapMessage(msg),
+		Message: mapMessage(msgs[0]),
 	}, nil
 }
 
 // GetMessageHistory implements chatv1.ChatServiceServer.
 func (c *ChatService) GetMessageHistory(ctx context.Context, req *chatv1.GetMessageHistoryRequest) (*chatv1.GetMessageHistoryResponse, error) {
+	user := auth.CtxGetUser(ctx)
+	if user == nil {
+		return nil, ErrUnauthenticated
+	}
 	ref, err := parseChannelRef(req.Channel)
 	if err != nil {
 		return nil, err
 	}
+	if err := c.srv.CheckPermission(ctx, user.ID, ref.ServerID, ref.ChannelID, confa.PermissionViewChannel); err != nil {
+		return nil, mapMessageError(err)
+	}
 
-	msgs, err := c.srv.GetMessagesHistory(ctx, ref.ServerID, ref.ChannelID, req.From.AsTime(), int(req.Count))
+	var page confa.HistoryPage
+	switch anchor := req.Anchor.(type) {
+	case *chatv1.GetMessageHistoryRequest_BeforeId:
+		page, err = c.getMessagesPage(ctx, ref, confa.HistoryBefore, anchor.BeforeId, req.Count)
+	case *chatv1.GetMessageHistoryRequest_AfterId:
+		page, err = c.getMessagesPage(ctx, ref, confa.HistoryAfter, anchor.AfterId, req.Count)
+	case *chatv1.GetMessageHistoryRequest_AroundId:
+		page, err = c.getMessagesPage(ctx, ref, confa.HistoryAround, anchor.AroundId, req.Count)
+	default:
+		if req.From == nil {
+			page, err = c.srv.GetMessagesPage(ctx, ref.ServerID, ref.ChannelID, confa.HistoryBefore, uuid.Nil, int(req.Count))
+			break
+		}
+		// Legacy clients paginate by timestamp only
+		page.Messages, err = c.srv.GetMessagesHistory(ctx, ref.ServerID, ref.ChannelID, req.From.AsTime(), int(req.Count))
+	}
 	if err != nil {
+		return nil, mapMessageError(err)
+	}
+
+	if err := c.srv.LoadReactions(ctx, user.ID, page.Messages); err != nil {
 		return nil, err
 	}
 
 	return &chatv1.GetMessageHistoryResponse{
-		Messages: apply(msgs, mapMessage),
+		Messages:      apply(page.Messages, mapMessage),
+		HasMoreBefore: page.HasMoreBefore,
+		HasMoreAfter:  page.HasMoreAfter,
 	}, nil
 }
 
+func (c *ChatService) getMessagesPage(ctx context.Context, ref channelRef, direction confa.HistoryDirection, anchorID string, count int32) (confa.HistoryPage, error) {
+	id, err := uuid.FromString(anchorID)
+	if err != nil {
+		return confa.HistoryPage{}, status.Errorf(codes.InvalidArgument, "invalid anchor message ID: %v", err)
+	}
+	return c.srv.GetMessagesPage(ctx, ref.ServerID, ref.ChannelID, direction, id, int(count))
+}
+
 // StreamNewMessages implements chatv1.ChatServiceServer.
 func (c *ChatService) StreamNewMessages(req *chatv1.StreamNewMessagesRequest, out grpc.ServerStreamingServer[chatv1.StreamNewMessagesResponse]) error {
+	user := auth.CtxGetUser(out.Context())
+	if user == nil {
+		return ErrUnauthenticated
+	}
 	channelID, err := uuid.FromString(req.Channel.ChannelId)
 	if err != nil {
 		return err
 	}
 
-	sub, err := c.srv.SubscribeNewMessages(out.Context(), channelID)
+	lastSeenID := uuid.Nil
+	if req.LastMessageId != "" {
+		lastSeenID, err = uuid.FromString(req.LastMessageId)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid last message ID: %v", err)
+		}
+	}
+
+	sub, err := c.srv.SubscribeNewMessages(out.Context(), user.ID, channelID, lastSeenID)
 	if err != nil {
-		return err
+		return mapMessageError(err)
 	}
 
+	return streamEvents(sub, out)
+}
+
+// streamEvents sends events of the subscription to the client until either side is done
+func streamEvents(sub *confa.ChannelSubscription, out grpc.ServerStreamingServer[chatv1.StreamNewMessagesResponse]) error {
 	defer sub.Close()
 
 	for {
 		select {
 		case <-out.Context().Done():
 			return nil
-		case msg := <-sub.Msgs:
-			err := out.Send(&chatv1.StreamNewMessagesResponse{MessageId: msg.String()})
+		case event, ok := <-sub.Events:
+			if !ok {
+				err := sub.Err()
+				if errors.Is(err, confa.ErrRemovedFromServer) || errors.Is(err, confa.ErrPermissionDenied) {
+					return status.Error(codes.PermissionDenied, err.Error())
+				}
+				if err != nil {
+					return status.Errorf(codes.Internal, "subscription failed: %v", err)
+				}
+				return nil
+			}
+
+			err := out.Send(&chatv1.StreamNewMessagesResponse{
+				MessageId: eventMessageID(event),
+				Type:      mapMessageEventType(event),
+				Event:     event,
+			})
 			if err != nil {
 				return err
 			}
@@ -228,3 +315,832 @@ func (c *ChatService) UploadAttachment(req grpc.ClientStreamingServer[chatv1.Upl
 		AttachmentId: result.info.ID.String(),
 	})
 }
+
+// EditMessage implements chatv1.ChatServiceServer.
+func (c *ChatService) EditMessage(ctx context.Context, req *chatv1.EditMessageRequest) (*chatv1.EditMessageResponse, error) {
+	user := auth.CtxGetUser(ctx)
+	if user == nil {
+		return nil, ErrUnauthenticated
+	}
+	ref, err := parseChannelRef(req.Channel)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.srv.CheckPermission(ctx, user.ID, ref.ServerID, ref.ChannelID, confa.PermissionSendMessages); err != nil {
+		return nil, mapMessageError(err)
+	}
+
+	messageID, err := uuid.FromString(req.MessageId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid message ID: %v", err)
+	}
+
+	msg, err := c.srv.EditMessage(ctx, user.ID, ref.ServerID, ref.ChannelID, messageID, req.Content)
+	if err != nil {
+		return nil, mapMessageError(err)
+	}
+
+	msgs := []store.Message{msg}
+	if err := c.srv.LoadReactions(ctx, user.ID, msgs); err != nil {
+		return nil, err
+	}
+	msg = msgs[0]
+
+	return &chatv1.EditMessageResponse{
+		Message: mapMessage(msg),
+	}, nil
+}
+
+// ListMessageRevisions implements chatv1.ChatServiceServer.
+func (c *ChatService) ListMessageRevisions(ctx context.Context, req *chatv1.ListMessageRevisionsRequest) (*chatv1.ListMessageRevisionsResponse, error) {
+	user := auth.CtxGetUser(ctx)
+	if user == nil {
+		return nil, ErrUnauthenticated
+	}
+	ref, err := parseChannelRef(req.Channel)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.srv.CheckPermission(ctx, user.ID, ref.ServerID, ref.ChannelID, confa.PermissionViewChannel); err != nil {
+		return nil, mapMessageError(err)
+	}
+
+	messageID, err := uuid.FromString(req.MessageId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid message ID: %v", err)
+	}
+
+	revisions, err := c.srv.ListMessageRevisions(ctx, user.ID, ref.ServerID, ref.ChannelID, messageID)
+	if err != nil {
+		return nil, mapMessageError(err)
+	}
+
+	return &chatv1.ListMessageRevisionsResponse{
+		Revisions: apply(revisions, mapMessageRevision),
+	}, nil
+}
+
+// DeleteMessage implements chatv1.ChatServiceServer.
+func (c *ChatService) DeleteMessage(ctx context.Context, req *chatv1.DeleteMessageRequest) (*chatv1.DeleteMessageResponse, error) {
+	user := auth.CtxGetUser(ctx)
+	if user == nil {
+		return nil, ErrUnauthenticated
+	}
+	ref, err := parseChannelRef(req.Channel)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.srv.CheckPermission(ctx, user.ID, ref.ServerID, ref.ChannelID, confa.PermissionViewChannel); err != nil {
+		return nil, mapMessageError(err)
+	}
+
+	messageID, err := uuid.FromString(req.MessageId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid message ID: %v", err)
+	}
+
+	err = c.srv.DeleteMessage(ctx, user.ID, ref.ServerID, ref.ChannelID, messageID)
+	if err != nil {
+		return nil, mapMessageError(err)
+	}
+
+	return &chatv1.DeleteMessageResponse{}, nil
+}
+
+// ListThreadMessages implements chatv1.ChatServiceServer.
+func (c *ChatService) ListThreadMessages(ctx context.Context, req *chatv1.ListThreadMessagesRequest) (*chatv1.ListThreadMessagesResponse, error) {
+	user := auth.CtxGetUser(ctx)
+	if user == nil {
+		return nil, ErrUnauthenticated
+	}
+	ref, err := parseChannelRef(req.Channel)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.srv.CheckPermission(ctx, user.ID, ref.ServerID, ref.ChannelID, confa.PermissionViewChannel); err != nil {
+		return nil, mapMessageError(err)
+	}
+
+	parentID, err := uuid.FromString(req.ParentMessageId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid parent message ID: %v", err)
+	}
+
+	afterID := uuid.Nil
+	if req.AfterMessageId != "" {
+		afterID, err = uuid.FromString(req.AfterMessageId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid after message ID: %v", err)
+		}
+	}
+
+	msgs, hasMore, err := c.srv.ListThreadMessages(ctx, ref.ServerID, ref.ChannelID, parentID, afterID, int(req.Count))
+	if err != nil {
+		return nil, mapMessageError(err)
+	}
+
+	if err := c.srv.LoadReactions(ctx, user.ID, msgs); err != nil {
+		return nil, err
+	}
+
+	return &chatv1.ListThreadMessagesResponse{
+		Messages: apply(msgs, mapMessage),
+		HasMore:  hasMore,
+	}, nil
+}
+
+// StreamThreadMessages implements chatv1.ChatServiceServer.
+func (c *ChatService) StreamThreadMessages(req *chatv1.StreamThreadMessagesRequest, out grpc.ServerStreamingServer[chatv1.StreamNewMessagesResponse]) error {
+	user := auth.CtxGetUser(out.Context())
+	if user == nil {
+		return ErrUnauthenticated
+	}
+	ref, err := parseChannelRef(req.Channel)
+	if err != nil {
+		return err
+	}
+
+	parentID, err := uuid.FromString(req.ParentMessageId)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid parent message ID: %v", err)
+	}
+
+	lastSeenID := uuid.Nil
+	if req.LastMessageId != "" {
+		lastSeenID, err = uuid.FromString(req.LastMessageId)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid last message ID: %v", err)
+		}
+	}
+
+	sub, err := c.srv.SubscribeThreadMessages(out.Context(), user.ID, ref.ChannelID, parentID, lastSeenID)
+	if err != nil {
+		return mapMessageError(err)
+	}
+
+	return streamEvents(sub, out)
+}
+
+// AddReaction implements chatv1.ChatServiceServer.
+func (c *ChatService) AddReaction(ctx context.Context, req *chatv1.AddReactionRequest) (*chatv1.AddReactionResponse, error) {
+	user := auth.CtxGetUser(ctx)
+	if user == nil {
+		return nil, ErrUnauthenticated
+	}
+	ref, err := parseChannelRef(req.Channel)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.srv.CheckPermission(ctx, user.ID, ref.ServerID, ref.ChannelID, confa.PermissionAddReactions); err != nil {
+		return nil, mapMessageError(err)
+	}
+
+	messageID, err := uuid.FromString(req.MessageId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid message ID: %v", err)
+	}
+
+	err = c.srv.AddReaction(ctx, user.ID, ref.ServerID, ref.ChannelID, messageID, req.Emoji)
+	if err != nil {
+		return nil, mapMessageError(err)
+	}
+
+	return &chatv1.AddReactionResponse{}, nil
+}
+
+// RemoveReaction implements chatv1.ChatServiceServer.
+func (c *ChatService) RemoveReaction(ctx context.Context, req *chatv1.RemoveReactionRequest) (*chatv1.RemoveReactionResponse, error) {
+	user := auth.CtxGetUser(ctx)
+	if user == nil {
+		return nil, ErrUnauthenticated
+	}
+	ref, err := parseChannelRef(req.Channel)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.srv.CheckPermission(ctx, user.ID, ref.ServerID, ref.ChannelID, confa.PermissionViewChannel); err != nil {
+		return nil, mapMessageError(err)
+	}
+
+	messageID, err := uuid.FromString(req.MessageId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid message ID: %v", err)
+	}
+
+	err = c.srv.RemoveReaction(ctx, user.ID, ref.ServerID, ref.ChannelID, messageID, req.Emoji)
+	if err != nil {
+		return nil, mapMessageError(err)
+	}
+
+	return &chatv1.RemoveReactionResponse{}, nil
+}
+
+// SearchMessages implements chatv1.ChatServiceServer.
+func (c *ChatService) SearchMessages(ctx context.Context, req *chatv1.SearchMessagesRequest) (*chatv1.SearchMessagesResponse, error) {
+	user := auth.CtxGetUser(ctx)
+	if user == nil {
+		return nil, ErrUnauthenticated
+	}
+
+	filter := confa.SearchFilter{
+		Query:         req.Query,
+		UserID:        user.ID,
+		HasAttachment: req.HasAttachment,
+	}
+
+	var err error
+	if filter.ServerID, err = parseOptionalID(req.ServerId); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid server ID: %v", err)
+	}
+	if filter.ChannelID, err = parseOptionalID(req.ChannelId); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid channel ID: %v", err)
+	}
+	if filter.AuthorID, err = parseOptionalID(req.AuthorId); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid author ID: %v", err)
+	}
+	if filter.ChannelID != uuid.Nil {
+		if err := c.srv.CheckPermission(ctx, user.ID, filter.ServerID, filter.ChannelID, confa.PermissionViewChannel); err != nil {
+			return nil, mapMessageError(err)
+		}
+	}
+	if req.From != nil {
+		filter.From = req.From.AsTime()
+	}
+	if req.To != nil {
+		filter.To = req.To.AsTime()
+	}
+
+	results, hasMore, err := c.srv.SearchMessages(ctx, filter, int(req.Count), int(req.Offset))
+	if errors.Is(err, confa.ErrEmptySearchQuery) || errors.Is(err, confa.ErrInvalidCount) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	msgs := make([]store.Message, len(results))
+	for i, result := range results {
+		msgs[i] = result.Message
+	}
+	if err := c.srv.LoadReactions(ctx, user.ID, msgs); err != nil {
+		return nil, err
+	}
+
+	protoResults := make([]*chatv1.SearchResult, len(results))
+	for i, result := range results {
+		protoResults[i] = &chatv1.SearchResult{
+			Channel: mapChannelRef(result.ServerID, msgs[i].ChannelID),
+			Message: mapMessage(msgs[i]),
+			Snippet: result.Snippet,
+			Rank:    result.Rank,
+		}
+	}
+
+	return &chatv1.SearchMessagesResponse{
+		Results: protoResults,
+		HasMore: hasMore,
+	}, nil
+}
+
+// ListMentions implements chatv1.ChatServiceServer.
+func (c *ChatService) ListMentions(ctx context.Context, req *chatv1.ListMentionsRequest) (*chatv1.ListMentionsResponse, error) {
+	user := auth.CtxGetUser(ctx)
+	if user == nil {
+		return nil, ErrUnauthenticated
+	}
+
+	beforeID, err := parseOptionalID(req.BeforeMentionId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid mention ID: %v", err)
+	}
+
+	mentions, hasMore, err := c.srv.ListMentions(ctx, user.ID, beforeID, req.UnacknowledgedOnly, int(req.Count))
+	if errors.Is(err, confa.ErrInvalidCount) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &chatv1.ListMentionsResponse{
+		Mentions: apply(mentions, mapMention),
+		HasMore:  hasMore,
+	}, nil
+}
+
+// AcknowledgeMentions implements chatv1.ChatServiceServer.
+func (c *ChatService) AcknowledgeMentions(ctx context.Context, req *chatv1.AcknowledgeMentionsRequest) (*chatv1.AcknowledgeMentionsResponse, error) {
+	user := auth.CtxGetUser(ctx)
+	if user == nil {
+		return nil, ErrUnauthenticated
+	}
+
+	if !req.All && len(req.MentionIds) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no mentions to acknowledge")
+	}
+
+	var mentionIDs []uuid.UUID
+	if !req.All {
+		mentionIDs = make([]uuid.UUID, len(req.MentionIds))
+		for i, idStr := range req.MentionIds {
+			id, err := uuid.FromString(idStr)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid mention ID: %v", err)
+			}
+			mentionIDs[i] = id
+		}
+	}
+
+	err := c.srv.AcknowledgeMentions(ctx, user.ID, mentionIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &chatv1.AcknowledgeMentionsResponse{}, nil
+}
+
+// StreamMentions implements chatv1.ChatServiceServer.
+func (c *ChatService) StreamMentions(req *chatv1.StreamMentionsRequest, out grpc.ServerStreamingServer[chatv1.StreamMentionsResponse]) error {
+	user := auth.CtxGetUser(out.Context())
+	if user == nil {
+		return ErrUnauthenticated
+	}
+
+	sub, err := c.srv.SubscribeMentions(out.Context(), user.ID)
+	if err != nil {
+		return err
+	}
+	defer sub.Close()
+
+	for {
+		select {
+		case <-out.Context().Done():
+			return nil
+		case mention := <-sub.Mentions:
+			err := out.Send(&chatv1.StreamMentionsResponse{Mention: mention})
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// MarkChannelRead implements chatv1.ChatServiceServer.
+func (c *ChatService) MarkChannelRead(ctx context.Context, req *chatv1.MarkChannelReadRequest) (*chatv1.MarkChannelReadResponse, error) {
+	user := auth.CtxGetUser(ctx)
+	if user == nil {
+		return nil, ErrUnauthenticated
+	}
+	ref, err := parseChannelRef(req.Channel)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.srv.CheckPermission(ctx, user.ID, ref.ServerID, ref.ChannelID, confa.PermissionViewChannel); err != nil {
+		return nil, mapMessageError(err)
+	}
+
+	messageID, err := parseOptionalID(req.MessageId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid message ID: %v", err)
+	}
+
+	state, err := c.srv.MarkChannelRead(ctx, user.ID, ref.ServerID, ref.ChannelID, messageID)
+	if err != nil {
+		return nil, mapMessageError(err)
+	}
+
+	return &chatv1.MarkChannelReadResponse{
+		ReadState: confa.ReadStateToProto(state, ref.ServerID),
+	}, nil
+}
+
+// StreamReadState implements chatv1.ChatServiceServer.
+func (c *ChatService) StreamReadState(req *chatv1.StreamReadStateRequest, out grpc.ServerStreamingServer[chatv1.StreamReadStateResponse]) error {
+	user := auth.CtxGetUser(out.Context())
+	if user == nil {
+		return ErrUnauthenticated
+	}
+
+	sub, err := c.srv.SubscribeReadState(out.Context(), user.ID)
+	if err != nil {
+		return err
+	}
+	defer sub.Close()
+
+	for {
+		select {
+		case <-out.Context().Done():
+			return nil
+		case state := <-sub.ReadStates:
+			err := out.Send(&chatv1.StreamReadStateResponse{ReadState: state})
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// SetTyping implements chatv1.ChatServiceServer.
+func (c *ChatService) SetTyping(ctx context.Context, req *chatv1.SetTypingRequest) (*chatv1.SetTypingResponse, error) {
+	user := auth.CtxGetUser(ctx)
+	if user == nil {
+		return nil, ErrUnauthenticated
+	}
+	ref, err := parseChannelRef(req.Channel)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.srv.CheckPermission(ctx, user.ID, ref.ServerID, ref.ChannelID, confa.PermissionSendMessages); err != nil {
+		return nil, mapMessageError(err)
+	}
+
+	err = c.srv.SetTyping(ctx, user.ID, ref.ServerID, ref.ChannelID, req.Typing)
+	if errors.Is(err, confa.ErrTypingThrottled) {
+		return nil, status.Error(codes.ResourceExhausted, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &chatv1.SetTypingResponse{}, nil
+}
+
+// PinMessage implements chatv1.ChatServiceServer.
+func (c *ChatService) PinMessage(ctx context.Context, req *chatv1.PinMessageRequest) (*chatv1.PinMessageResponse, error) {
+	user := auth.CtxGetUser(ctx)
+	if user == nil {
+		return nil, ErrUnauthenticated
+	}
+	ref, err := parseChannelRef(req.Channel)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.srv.CheckPermission(ctx, user.ID, ref.ServerID, ref.ChannelID, confa.PermissionManageMessages); err != nil {
+		return nil, mapMessageError(err)
+	}
+
+	messageID, err := uuid.FromString(req.MessageId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid message ID: %v", err)
+	}
+
+	err = c.srv.PinMessage(ctx, user.ID, ref.ServerID, ref.ChannelID, messageID)
+	if err != nil {
+		return nil, mapMessageError(err)
+	}
+
+	return &chatv1.PinMessageResponse{}, nil
+}
+
+// UnpinMessage implements chatv1.ChatServiceServer.
+func (c *ChatService) UnpinMessage(ctx context.Context, req *chatv1.UnpinMessageRequest) (*chatv1.UnpinMessageResponse, error) {
+	user := auth.CtxGetUser(ctx)
+	if user == nil {
+		return nil, ErrUnauthenticated
+	}
+	ref, err := parseChannelRef(req.Channel)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.srv.CheckPermission(ctx, user.ID, ref.ServerID, ref.ChannelID, confa.PermissionManageMessages); err != nil {
+		return nil, mapMessageError(err)
+	}
+
+	messageID, err := uuid.FromString(req.MessageId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid message ID: %v", err)
+	}
+
+	err = c.srv.UnpinMessage(ctx, user.ID, ref.ServerID, ref.ChannelID, messageID)
+	if err != nil {
+		return nil, mapMessageError(err)
+	}
+
+	return &chatv1.UnpinMessageResponse{}, nil
+}
+
+// ListPinnedMessages implements chatv1.ChatServiceServer.
+func (c *ChatService) ListPinnedMessages(ctx context.Context, req *chatv1.ListPinnedMessagesRequest) (*chatv1.ListPinnedMessagesResponse, error) {
+	user := auth.CtxGetUser(ctx)
+	if user == nil {
+		return nil, ErrUnauthenticated
+	}
+	ref, err := parseChannelRef(req.Channel)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.srv.CheckPermission(ctx, user.ID, ref.ServerID, ref.ChannelID, confa.PermissionViewChannel); err != nil {
+		return nil, mapMessageError(err)
+	}
+
+	pins, err := c.srv.ListPinnedMessages(ctx, ref.ServerID, ref.ChannelID)
+	if err != nil {
+		return nil, err
+	}
+
+	msgs := make([]store.Message, len(pins))
+	for i, pin := range pins {
+		msgs[i] = *pin.Message
+	}
+	if err := c.srv.LoadReactions(ctx, user.ID, msgs); err != nil {
+		return nil, err
+	}
+	for i := range pins {
+		pins[i].Message = &msgs[i]
+	}
+
+	return &chatv1.ListPinnedMessagesResponse{
+		Pins: apply(pins, mapPinnedMessage),
+	}, nil
+}
+
+// ScheduleMessage implements chatv1.ChatServiceServer.
+func (c *ChatService) ScheduleMessage(ctx context.Context, req *chatv1.ScheduleMessageRequest) (*chatv1.ScheduleMessageResponse, error) {
+	user := auth.CtxGetUser(ctx)
+	if user == nil {
+		return nil, ErrUnauthenticated
+	}
+	ref, err := parseChannelRef(req.Channel)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.srv.CheckPermission(ctx, user.ID, ref.ServerID, ref.ChannelID, confa.PermissionSendMessages); err != nil {
+		return nil, mapMessageError(err)
+	}
+	if req.SendAt == nil {
+		return nil, status.Error(codes.InvalidArgument, "send time is required")
+	}
+
+	replyToID, err := parseOptionalID(req.ReplyToMessageId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid reply message ID: %v", err)
+	}
+
+	attachmentIDs, attachmentNames, err := c.resolveAttachments(ctx, req.AttachmentIds)
+	if err != nil {
+		return nil, err
+	}
+
+	scheduled, err := c.srv.ScheduleMessage(ctx, user.ID, ref.ServerID, ref.ChannelID, replyToID, req.Content, attachmentIDs, attachmentNames, req.SendAt.AsTime())
+	if err != nil {
+		return nil, mapMessageError(err)
+	}
+
+	return &chatv1.ScheduleMessageResponse{
+		ScheduledMessage: mapScheduledMessage(scheduled),
+	}, nil
+}
+
+// ListScheduledMessages implements chatv1.ChatServiceServer.
+func (c *ChatService) ListScheduledMessages(ctx context.Context, req *chatv1.ListScheduledMessagesRequest) (*chatv1.ListScheduledMessagesResponse, error) {
+	user := auth.CtxGetUser(ctx)
+	if user == nil {
+		return nil, ErrUnauthenticated
+	}
+
+	channelID := uuid.Nil
+	if req.Channel != nil {
+		ref, err := parseChannelRef(req.Channel)
+		if err != nil {
+			return nil, err
+		}
+		channelID = ref.ChannelID
+	}
+
+	scheduled, err := c.srv.ListScheduledMessages(ctx, user.ID, channelID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &chatv1.ListScheduledMessagesResponse{
+		ScheduledMessages: apply(scheduled, mapScheduledMessage),
+	}, nil
+}
+
+// CancelScheduledMessage implements chatv1.ChatServiceServer.
+func (c *ChatService) CancelScheduledMessage(ctx context.Context, req *chatv1.CancelScheduledMessageRequest) (*chatv1.CancelScheduledMessageResponse, error) {
+	user := auth.CtxGetUser(ctx)
+	if user == nil {
+		return nil, ErrUnauthenticated
+	}
+
+	scheduledID, err := uuid.FromString(req.ScheduledMessageId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid scheduled message ID: %v", err)
+	}
+
+	err = c.srv.CancelScheduledMessage(ctx, user.ID, scheduledID)
+	if err != nil {
+		return nil, mapMessageError(err)
+	}
+
+	return &chatv1.CancelScheduledMessageResponse{}, nil
+}
+
+// OpenDirectMessage implements chatv1.ChatServiceServer.
+func (c *ChatService) OpenDirectMessage(ctx context.Context, req *chatv1.OpenDirectMessageRequest) (*chatv1.OpenDirectMessageResponse, error) {
+	user := auth.CtxGetUser(ctx)
+	if user == nil {
+		return nil, ErrUnauthenticated
+	}
+
+	otherID, err := uuid.FromString(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+	}
+
+	dm, err := c.srv.OpenDirectMessage(ctx, user.ID, otherID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	if err != nil {
+		return nil, mapMessageError(err)
+	}
+
+	return &chatv1.OpenDirectMessageResponse{
+		DirectMessage: mapDirectMessage(dm),
+	}, nil
+}
+
+// ListDirectMessages implements chatv1.ChatServiceServer.
+func (c *ChatService) ListDirectMessages(ctx context.Context, req *chatv1.ListDirectMessagesRequest) (*chatv1.ListDirectMessagesResponse, error) {
+	user := auth.CtxGetUser(ctx)
+	if user == nil {
+		return nil, ErrUnauthenticated
+	}
+
+	dms, err := c.srv.ListDirectMessages(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &chatv1.ListDirectMessagesResponse{
+		DirectMessages: apply(dms, mapDirectMessage),
+	}, nil
+}
+
+// CreateGroup implements chatv1.ChatServiceServer.
+func (c *ChatService) CreateGroup(ctx context.Context, req *chatv1.CreateGroupRequest) (*chatv1.CreateGroupResponse, error) {
+	user := auth.CtxGetUser(ctx)
+	if user == nil {
+		return nil, ErrUnauthenticated
+	}
+
+	userIDs, err := parseUserIDs(req.UserIds)
+	if err != nil {
+		return nil, err
+	}
+
+	dm, err := c.srv.CreateGroup(ctx, user.ID, req.Name, userIDs)
+	if err != nil {
+		return nil, mapGroupError(err)
+	}
+
+	return &chatv1.CreateGroupResponse{
+		DirectMessage: mapDirectMessage(dm),
+	}, nil
+}
+
+// AddGroupMembers implements chatv1.ChatServiceServer.
+func (c *ChatService) AddGroupMembers(ctx context.Context, req *chatv1.AddGroupMembersRequest) (*chatv1.AddGroupMembersResponse, error) {
+	user := auth.CtxGetUser(ctx)
+	if user == nil {
+		return nil, ErrUnauthenticated
+	}
+
+	channelID, err := uuid.FromString(req.ChannelId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid channel ID: %v", err)
+	}
+	userIDs, err := parseUserIDs(req.UserIds)
+	if err != nil {
+		return nil, err
+	}
+
+	dm, err := c.srv.AddGroupMembers(ctx, user.ID, channelID, userIDs)
+	if err != nil {
+		return nil, mapGroupError(err)
+	}
+
+	return &chatv1.AddGroupMembersResponse{
+		DirectMessage: mapDirectMessage(dm),
+	}, nil
+}
+
+// RemoveGroupMember implements chatv1.ChatServiceServer.
+func (c *ChatService) RemoveGroupMember(ctx context.Context, req *chatv1.RemoveGroupMemberRequest) (*chatv1.RemoveGroupMemberResponse, error) {
+	user := auth.CtxGetUser(ctx)
+	if user == nil {
+		return nil, ErrUnauthenticated
+	}
+
+	channelID, err := uuid.FromString(req.ChannelId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid channel ID: %v", err)
+	}
+	userID, err := uuid.FromString(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+	}
+
+	err = c.srv.RemoveGroupMember(ctx, user.ID, channelID, userID)
+	if err != nil {
+		return nil, mapGroupError(err)
+	}
+
+	return &chatv1.RemoveGroupMemberResponse{}, nil
+}
+
+// RenameGroup implements chatv1.ChatServiceServer.
+func (c *ChatService) RenameGroup(ctx context.Context, req *chatv1.RenameGroupRequest) (*chatv1.RenameGroupResponse, error) {
+	user := auth.CtxGetUser(ctx)
+	if user == nil {
+		return nil, ErrUnauthenticated
+	}
+
+	channelID, err := uuid.FromString(req.ChannelId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid channel ID: %v", err)
+	}
+
+	dm, err := c.srv.RenameGroup(ctx, user.ID, channelID, req.Name)
+	if err != nil {
+		return nil, mapGroupError(err)
+	}
+
+	return &chatv1.RenameGroupResponse{
+		DirectMessage: mapDirectMessage(dm),
+	}, nil
+}
+
+// LeaveGroup implements chatv1.ChatServiceServer.
+func (c *ChatService) LeaveGroup(ctx context.Context, req *chatv1.LeaveGroupRequest) (*chatv1.LeaveGroupResponse, error) {
+	user := auth.CtxGetUser(ctx)
+	if user == nil {
+		return nil, ErrUnauthenticated
+	}
+
+	channelID, err := uuid.FromString(req.ChannelId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid channel ID: %v", err)
+	}
+
+	err = c.srv.LeaveGroup(ctx, user.ID, channelID)
+	if err != nil {
+		return nil, mapGroupError(err)
+	}
+
+	return &chatv1.LeaveGroupResponse{}, nil
+}
+
+func parseUserIDs(ids []string) ([]uuid.UUID, error) {
+	userIDs := make([]uuid.UUID, len(ids))
+	for i, idStr := range ids {
+		id, err := uuid.FromString(idStr)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+		}
+		userIDs[i] = id
+	}
+	return userIDs, nil
+}
+
+// mapGroupError converts group membership errors from the service to gRPC status errors
+func mapGroupError(err error) error {
+	switch {
+	case errors.Is(err, confa.ErrGroupFull):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case errors.Is(err, confa.ErrNotGroup):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, confa.ErrInvalidGroupName), errors.Is(err, confa.ErrInvalidDirectMessageUser):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, confa.ErrPermissionDenied):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, sql.ErrNoRows):
+		return status.Error(codes.NotFound, "group or user not found")
+	default:
+		return err
+	}
+}
+
+// mapMessageError converts message errors from the service to gRPC status errors
+func mapMessageError(err error) error {
+	switch {
+	case errors.Is(err, confa.ErrNotMessageAuthor), errors.Is(err, confa.ErrPermissionDenied),
+		errors.Is(err, confa.ErrMuted):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, confa.ErrPinLimitReached):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case errors.Is(err, confa.ErrMessageDeleted), errors.Is(err, confa.ErrScheduledMessageSending),
+		errors.Is(err, confa.ErrSystemMessage):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, confa.ErrInvalidReplyTarget), errors.Is(err, confa.ErrInvalidReaction),
+		errors.Is(err, confa.ErrInvalidSendTime), errors.Is(err, confa.ErrInvalidTTL), errors.Is(err, confa.ErrInvalidCount),
+		errors.Is(err, confa.ErrInvalidDirectMessageUser):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, sql.ErrNoRows):
+		return status.Error(codes.NotFound, "message not found")
+	default:
+		return err
+	}
+}
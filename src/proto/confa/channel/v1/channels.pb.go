@@ -9,6 +9,7 @@ package channelv1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -104,12 +105,16 @@ func (*Channel_TextChannel) isChannel_Channel() {}
 func (*Channel_VoiceChannel) isChannel_Channel() {}
 
 type TextChannel struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
-	ChannelId     string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ServerId          string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	ChannelId         string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Name              string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	UnreadCount       int32                  `protobuf:"varint,4,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
+	MentionCount      int32                  `protobuf:"varint,5,opt,name=mention_count,json=mentionCount,proto3" json:"mention_count,omitempty"`
+	LastReadMessageId string                 `protobuf:"bytes,6,opt,name=last_read_message_id,json=lastReadMessageId,proto3" json:"last_read_message_id,omitempty"`
+	MessageTtl        *durationpb.Duration   `protobuf:"bytes,7,opt,name=message_ttl,json=messageTtl,proto3" json:"message_ttl,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *TextChannel) Reset() {
@@ -163,6 +168,34 @@ func (x *TextChannel) GetName() string {
 	return ""
 }
 
+func (x *TextChannel) GetUnreadCount() int32 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+func (x *TextChannel) GetMentionCount() int32 {
+	if x != nil {
+		return x.MentionCount
+	}
+	return 0
+}
+
+func (x *TextChannel) GetLastReadMessageId() string {
+	if x != nil {
+		return x.LastReadMessageId
+	}
+	return ""
+}
+
+func (x *TextChannel) GetMessageTtl() *durationpb.Duration {
+	if x != nil {
+		return x.MessageTtl
+	}
+	return nil
+}
+
 type VoiceChannel struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
@@ -235,16 +268,21 @@ var File_confa_channel_v1_channels_proto protoreflect.FileDescriptor
 
 const file_confa_channel_v1_channels_proto_rawDesc = "" +
 	"\n" +
-	"\x1fconfa/channel/v1/channels.proto\x12\x10confa.channel.v1\"\x9f\x01\n" +
+	"\x1fconfa/channel/v1/channels.proto\x12\x10confa.channel.v1\x1a\x1egoogle/protobuf/duration.proto\"\x9f\x01\n" +
 	"\aChannel\x12B\n" +
 	"\ftext_channel\x18\x01 \x01(\v2\x1d.confa.channel.v1.TextChannelH\x00R\vtextChannel\x12E\n" +
 	"\rvoice_channel\x18\x02 \x01(\v2\x1e.confa.channel.v1.VoiceChannelH\x00R\fvoiceChannelB\t\n" +
-	"\achannel\"]\n" +
+	"\achannel\"\x92\x02\n" +
 	"\vTextChannel\x12\x1b\n" +
 	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12\x1d\n" +
 	"\n" +
 	"channel_id\x18\x02 \x01(\tR\tchannelId\x12\x12\n" +
-	"\x04name\x18\x03 \x01(\tR\x04name\"\x84\x01\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12!\n" +
+	"\funread_count\x18\x04 \x01(\x05R\vunreadCount\x12#\n" +
+	"\rmention_count\x18\x05 \x01(\x05R\fmentionCount\x12/\n" +
+	"\x14last_read_message_id\x18\x06 \x01(\tR\x11lastReadMessageId\x12:\n" +
+	"\vmessage_ttl\x18\a \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"messageTtl\"\x84\x01\n" +
 	"\fVoiceChannel\x12\x1b\n" +
 	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12\x1d\n" +
 	"\n" +
@@ -267,18 +305,20 @@ func file_confa_channel_v1_channels_proto_rawDescGZIP() []byte {
 
 var file_confa_channel_v1_channels_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_confa_channel_v1_channels_proto_goTypes = []any{
-	(*Channel)(nil),      // 0: confa.channel.v1.Channel
-	(*TextChannel)(nil),  // 1: confa.channel.v1.TextChannel
-	(*VoiceChannel)(nil), // 2: confa.channel.v1.VoiceChannel
+	(*Channel)(nil),             // 0: confa.channel.v1.Channel
+	(*TextChannel)(nil),         // 1: confa.channel.v1.TextChannel
+	(*VoiceChannel)(nil),        // 2: confa.channel.v1.VoiceChannel
+	(*durationpb.Duration)(nil), // 3: google.protobuf.Duration
 }
 var file_confa_channel_v1_channels_proto_depIdxs = []int32{
 	1, // 0: confa.channel.v1.Channel.text_channel:type_name -> confa.channel.v1.TextChannel
 	2, // 1: confa.channel.v1.Channel.voice_channel:type_name -> confa.channel.v1.VoiceChannel
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	3, // 2: confa.channel.v1.TextChannel.message_ttl:type_name -> google.protobuf.Duration
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_confa_channel_v1_channels_proto_init() }
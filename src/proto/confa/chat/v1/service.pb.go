@@ -7,8 +7,10 @@
 package chatv1
 
 import (
+	v1 "github.com/confa-chat/node/src/proto/confa/channel/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -22,6 +24,229 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type SystemEventType int32
+
+const (
+	SystemEventType_SYSTEM_EVENT_TYPE_UNSPECIFIED    SystemEventType = 0
+	SystemEventType_SYSTEM_EVENT_TYPE_GROUP_CREATED  SystemEventType = 1
+	SystemEventType_SYSTEM_EVENT_TYPE_MEMBERS_ADDED  SystemEventType = 2
+	SystemEventType_SYSTEM_EVENT_TYPE_MEMBER_REMOVED SystemEventType = 3
+	SystemEventType_SYSTEM_EVENT_TYPE_MEMBER_LEFT    SystemEventType = 4
+	SystemEventType_SYSTEM_EVENT_TYPE_GROUP_RENAMED  SystemEventType = 5
+)
+
+// Enum value maps for SystemEventType.
+var (
+	SystemEventType_name = map[int32]string{
+		0: "SYSTEM_EVENT_TYPE_UNSPECIFIED",
+		1: "SYSTEM_EVENT_TYPE_GROUP_CREATED",
+		2: "SYSTEM_EVENT_TYPE_MEMBERS_ADDED",
+		3: "SYSTEM_EVENT_TYPE_MEMBER_REMOVED",
+		4: "SYSTEM_EVENT_TYPE_MEMBER_LEFT",
+		5: "SYSTEM_EVENT_TYPE_GROUP_RENAMED",
+	}
+	SystemEventType_value = map[string]int32{
+		"SYSTEM_EVENT_TYPE_UNSPECIFIED":    0,
+		"SYSTEM_EVENT_TYPE_GROUP_CREATED":  1,
+		"SYSTEM_EVENT_TYPE_MEMBERS_ADDED":  2,
+		"SYSTEM_EVENT_TYPE_MEMBER_REMOVED": 3,
+		"SYSTEM_EVENT_TYPE_MEMBER_LEFT":    4,
+		"SYSTEM_EVENT_TYPE_GROUP_RENAMED":  5,
+	}
+)
+
+func (x SystemEventType) Enum() *SystemEventType {
+	p := new(SystemEventType)
+	*p = x
+	return p
+}
+
+func (x SystemEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SystemEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_confa_chat_v1_service_proto_enumTypes[0].Descriptor()
+}
+
+func (SystemEventType) Type() protoreflect.EnumType {
+	return &file_confa_chat_v1_service_proto_enumTypes[0]
+}
+
+func (x SystemEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SystemEventType.Descriptor instead.
+func (SystemEventType) EnumDescriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{0}
+}
+
+type ContentNodeType int32
+
+const (
+	ContentNodeType_CONTENT_NODE_TYPE_UNSPECIFIED ContentNodeType = 0
+	ContentNodeType_CONTENT_NODE_TYPE_TEXT        ContentNodeType = 1
+	ContentNodeType_CONTENT_NODE_TYPE_BOLD        ContentNodeType = 2
+	ContentNodeType_CONTENT_NODE_TYPE_ITALIC      ContentNodeType = 3
+	ContentNodeType_CONTENT_NODE_TYPE_CODE        ContentNodeType = 4
+	ContentNodeType_CONTENT_NODE_TYPE_CODE_BLOCK  ContentNodeType = 5
+	ContentNodeType_CONTENT_NODE_TYPE_LINK        ContentNodeType = 6
+	ContentNodeType_CONTENT_NODE_TYPE_MENTION     ContentNodeType = 7
+	ContentNodeType_CONTENT_NODE_TYPE_SPOILER     ContentNodeType = 8
+)
+
+// Enum value maps for ContentNodeType.
+var (
+	ContentNodeType_name = map[int32]string{
+		0: "CONTENT_NODE_TYPE_UNSPECIFIED",
+		1: "CONTENT_NODE_TYPE_TEXT",
+		2: "CONTENT_NODE_TYPE_BOLD",
+		3: "CONTENT_NODE_TYPE_ITALIC",
+		4: "CONTENT_NODE_TYPE_CODE",
+		5: "CONTENT_NODE_TYPE_CODE_BLOCK",
+		6: "CONTENT_NODE_TYPE_LINK",
+		7: "CONTENT_NODE_TYPE_MENTION",
+		8: "CONTENT_NODE_TYPE_SPOILER",
+	}
+	ContentNodeType_value = map[string]int32{
+		"CONTENT_NODE_TYPE_UNSPECIFIED": 0,
+		"CONTENT_NODE_TYPE_TEXT":        1,
+		"CONTENT_NODE_TYPE_BOLD":        2,
+		"CONTENT_NODE_TYPE_ITALIC":      3,
+		"CONTENT_NODE_TYPE_CODE":        4,
+		"CONTENT_NODE_TYPE_CODE_BLOCK":  5,
+		"CONTENT_NODE_TYPE_LINK":        6,
+		"CONTENT_NODE_TYPE_MENTION":     7,
+		"CONTENT_NODE_TYPE_SPOILER":     8,
+	}
+)
+
+func (x ContentNodeType) Enum() *ContentNodeType {
+	p := new(ContentNodeType)
+	*p = x
+	return p
+}
+
+func (x ContentNodeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ContentNodeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_confa_chat_v1_service_proto_enumTypes[1].Descriptor()
+}
+
+func (ContentNodeType) Type() protoreflect.EnumType {
+	return &file_confa_chat_v1_service_proto_enumTypes[1]
+}
+
+func (x ContentNodeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ContentNodeType.Descriptor instead.
+func (ContentNodeType) EnumDescriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{1}
+}
+
+type MessageEventType int32
+
+const (
+	MessageEventType_MESSAGE_EVENT_TYPE_CREATED MessageEventType = 0
+	MessageEventType_MESSAGE_EVENT_TYPE_EDITED  MessageEventType = 1
+	MessageEventType_MESSAGE_EVENT_TYPE_DELETED MessageEventType = 2
+)
+
+// Enum value maps for MessageEventType.
+var (
+	MessageEventType_name = map[int32]string{
+		0: "MESSAGE_EVENT_TYPE_CREATED",
+		1: "MESSAGE_EVENT_TYPE_EDITED",
+		2: "MESSAGE_EVENT_TYPE_DELETED",
+	}
+	MessageEventType_value = map[string]int32{
+		"MESSAGE_EVENT_TYPE_CREATED": 0,
+		"MESSAGE_EVENT_TYPE_EDITED":  1,
+		"MESSAGE_EVENT_TYPE_DELETED": 2,
+	}
+)
+
+func (x MessageEventType) Enum() *MessageEventType {
+	p := new(MessageEventType)
+	*p = x
+	return p
+}
+
+func (x MessageEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MessageEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_confa_chat_v1_service_proto_enumTypes[2].Descriptor()
+}
+
+func (MessageEventType) Type() protoreflect.EnumType {
+	return &file_confa_chat_v1_service_proto_enumTypes[2]
+}
+
+func (x MessageEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MessageEventType.Descriptor instead.
+func (MessageEventType) EnumDescriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{2}
+}
+
+type MentionKind int32
+
+const (
+	MentionKind_MENTION_KIND_USER     MentionKind = 0
+	MentionKind_MENTION_KIND_EVERYONE MentionKind = 1
+	MentionKind_MENTION_KIND_CHANNEL  MentionKind = 2
+)
+
+// Enum value maps for MentionKind.
+var (
+	MentionKind_name = map[int32]string{
+		0: "MENTION_KIND_USER",
+		1: "MENTION_KIND_EVERYONE",
+		2: "MENTION_KIND_CHANNEL",
+	}
+	MentionKind_value = map[string]int32{
+		"MENTION_KIND_USER":     0,
+		"MENTION_KIND_EVERYONE": 1,
+		"MENTION_KIND_CHANNEL":  2,
+	}
+)
+
+func (x MentionKind) Enum() *MentionKind {
+	p := new(MentionKind)
+	*p = x
+	return p
+}
+
+func (x MentionKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MentionKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_confa_chat_v1_service_proto_enumTypes[3].Descriptor()
+}
+
+func (MentionKind) Type() protoreflect.EnumType {
+	return &file_confa_chat_v1_service_proto_enumTypes[3]
+}
+
+func (x MentionKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MentionKind.Descriptor instead.
+func (MentionKind) EnumDescriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{3}
+}
+
 type TextChannelRef struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
@@ -75,12 +300,14 @@ func (x *TextChannelRef) GetChannelId() string {
 }
 
 type SendMessageRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Channel       *TextChannelRef        `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
-	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
-	AttachmentIds []string               `protobuf:"bytes,3,rep,name=attachment_ids,json=attachmentIds,proto3" json:"attachment_ids,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Channel          *TextChannelRef        `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Content          string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	AttachmentIds    []string               `protobuf:"bytes,3,rep,name=attachment_ids,json=attachmentIds,proto3" json:"attachment_ids,omitempty"`
+	ReplyToMessageId string                 `protobuf:"bytes,4,opt,name=reply_to_message_id,json=replyToMessageId,proto3" json:"reply_to_message_id,omitempty"`
+	Ttl              *durationpb.Duration   `protobuf:"bytes,5,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *SendMessageRequest) Reset() {
@@ -134,6 +361,20 @@ func (x *SendMessageRequest) GetAttachmentIds() []string {
 	return nil
 }
 
+func (x *SendMessageRequest) GetReplyToMessageId() string {
+	if x != nil {
+		return x.ReplyToMessageId
+	}
+	return ""
+}
+
+func (x *SendMessageRequest) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
 type SendMessageResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
@@ -179,14 +420,23 @@ func (x *SendMessageResponse) GetMessageId() string {
 }
 
 type Message struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
-	SenderId      string                 `protobuf:"bytes,4,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
-	Content       string                 `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
-	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Attachments   []*Attachment          `protobuf:"bytes,7,rep,name=attachments,proto3" json:"attachments,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	MessageId       string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	SenderId        string                 `protobuf:"bytes,4,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
+	Content         string                 `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	Timestamp       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Attachments     []*Attachment          `protobuf:"bytes,7,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	EditedAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"`
+	DeletedAt       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	ParentMessageId string                 `protobuf:"bytes,10,opt,name=parent_message_id,json=parentMessageId,proto3" json:"parent_message_id,omitempty"`
+	ReplyCount      int32                  `protobuf:"varint,11,opt,name=reply_count,json=replyCount,proto3" json:"reply_count,omitempty"`
+	Reactions       []*Reaction            `protobuf:"bytes,12,rep,name=reactions,proto3" json:"reactions,omitempty"`
+	ContentNodes    []*ContentNode         `protobuf:"bytes,13,rep,name=content_nodes,json=contentNodes,proto3" json:"content_nodes,omitempty"`
+	Previews        []*LinkPreview         `protobuf:"bytes,14,rep,name=previews,proto3" json:"previews,omitempty"`
+	ExpiresAt       *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	SystemEvent     *SystemEvent           `protobuf:"bytes,16,opt,name=system_event,json=systemEvent,proto3" json:"system_event,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *Message) Reset() {
@@ -254,29 +504,92 @@ func (x *Message) GetAttachments() []*Attachment {
 	return nil
 }
 
-type Attachment struct {
+func (x *Message) GetEditedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EditedAt
+	}
+	return nil
+}
+
+func (x *Message) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Message) GetParentMessageId() string {
+	if x != nil {
+		return x.ParentMessageId
+	}
+	return ""
+}
+
+func (x *Message) GetReplyCount() int32 {
+	if x != nil {
+		return x.ReplyCount
+	}
+	return 0
+}
+
+func (x *Message) GetReactions() []*Reaction {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+func (x *Message) GetContentNodes() []*ContentNode {
+	if x != nil {
+		return x.ContentNodes
+	}
+	return nil
+}
+
+func (x *Message) GetPreviews() []*LinkPreview {
+	if x != nil {
+		return x.Previews
+	}
+	return nil
+}
+
+func (x *Message) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Message) GetSystemEvent() *SystemEvent {
+	if x != nil {
+		return x.SystemEvent
+	}
+	return nil
+}
+
+type SystemEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AttachmentId  string                 `protobuf:"bytes,1,opt,name=attachment_id,json=attachmentId,proto3" json:"attachment_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	Type          SystemEventType        `protobuf:"varint,1,opt,name=type,proto3,enum=confa.chat.v1.SystemEventType" json:"type,omitempty"`
+	UserIds       []string               `protobuf:"bytes,2,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Attachment) Reset() {
-	*x = Attachment{}
+func (x *SystemEvent) Reset() {
+	*x = SystemEvent{}
 	mi := &file_confa_chat_v1_service_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Attachment) String() string {
+func (x *SystemEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Attachment) ProtoMessage() {}
+func (*SystemEvent) ProtoMessage() {}
 
-func (x *Attachment) ProtoReflect() protoreflect.Message {
+func (x *SystemEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_confa_chat_v1_service_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -288,55 +601,57 @@ func (x *Attachment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
-func (*Attachment) Descriptor() ([]byte, []int) {
+// Deprecated: Use SystemEvent.ProtoReflect.Descriptor instead.
+func (*SystemEvent) Descriptor() ([]byte, []int) {
 	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *Attachment) GetAttachmentId() string {
+func (x *SystemEvent) GetType() SystemEventType {
 	if x != nil {
-		return x.AttachmentId
+		return x.Type
 	}
-	return ""
+	return SystemEventType_SYSTEM_EVENT_TYPE_UNSPECIFIED
 }
 
-func (x *Attachment) GetName() string {
+func (x *SystemEvent) GetUserIds() []string {
 	if x != nil {
-		return x.Name
+		return x.UserIds
 	}
-	return ""
+	return nil
 }
 
-func (x *Attachment) GetUrl() string {
+func (x *SystemEvent) GetName() string {
 	if x != nil {
-		return x.Url
+		return x.Name
 	}
 	return ""
 }
 
-type GetMessageHistoryRequest struct {
+type LinkPreview struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Channel       *TextChannelRef        `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
-	From          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
-	Count         int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	SiteName      string                 `protobuf:"bytes,4,opt,name=site_name,json=siteName,proto3" json:"site_name,omitempty"`
+	ThumbnailUrl  string                 `protobuf:"bytes,5,opt,name=thumbnail_url,json=thumbnailUrl,proto3" json:"thumbnail_url,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMessageHistoryRequest) Reset() {
-	*x = GetMessageHistoryRequest{}
+func (x *LinkPreview) Reset() {
+	*x = LinkPreview{}
 	mi := &file_confa_chat_v1_service_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMessageHistoryRequest) String() string {
+func (x *LinkPreview) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMessageHistoryRequest) ProtoMessage() {}
+func (*LinkPreview) ProtoMessage() {}
 
-func (x *GetMessageHistoryRequest) ProtoReflect() protoreflect.Message {
+func (x *LinkPreview) ProtoReflect() protoreflect.Message {
 	mi := &file_confa_chat_v1_service_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -348,53 +663,71 @@ func (x *GetMessageHistoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMessageHistoryRequest.ProtoReflect.Descriptor instead.
-func (*GetMessageHistoryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use LinkPreview.ProtoReflect.Descriptor instead.
+func (*LinkPreview) Descriptor() ([]byte, []int) {
 	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetMessageHistoryRequest) GetChannel() *TextChannelRef {
+func (x *LinkPreview) GetUrl() string {
 	if x != nil {
-		return x.Channel
+		return x.Url
 	}
-	return nil
+	return ""
 }
 
-func (x *GetMessageHistoryRequest) GetFrom() *timestamppb.Timestamp {
+func (x *LinkPreview) GetTitle() string {
 	if x != nil {
-		return x.From
+		return x.Title
 	}
-	return nil
+	return ""
 }
 
-func (x *GetMessageHistoryRequest) GetCount() int32 {
+func (x *LinkPreview) GetDescription() string {
 	if x != nil {
-		return x.Count
+		return x.Description
 	}
-	return 0
+	return ""
 }
 
-type GetMessageHistoryResponse struct {
+func (x *LinkPreview) GetSiteName() string {
+	if x != nil {
+		return x.SiteName
+	}
+	return ""
+}
+
+func (x *LinkPreview) GetThumbnailUrl() string {
+	if x != nil {
+		return x.ThumbnailUrl
+	}
+	return ""
+}
+
+type ContentNode struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Messages      []*Message             `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	Type          ContentNodeType        `protobuf:"varint,1,opt,name=type,proto3,enum=confa.chat.v1.ContentNodeType" json:"type,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	Language      string                 `protobuf:"bytes,4,opt,name=language,proto3" json:"language,omitempty"`
+	Children      []*ContentNode         `protobuf:"bytes,5,rep,name=children,proto3" json:"children,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMessageHistoryResponse) Reset() {
-	*x = GetMessageHistoryResponse{}
+func (x *ContentNode) Reset() {
+	*x = ContentNode{}
 	mi := &file_confa_chat_v1_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMessageHistoryResponse) String() string {
+func (x *ContentNode) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMessageHistoryResponse) ProtoMessage() {}
+func (*ContentNode) ProtoMessage() {}
 
-func (x *GetMessageHistoryResponse) ProtoReflect() protoreflect.Message {
+func (x *ContentNode) ProtoReflect() protoreflect.Message {
 	mi := &file_confa_chat_v1_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -406,40 +739,69 @@ func (x *GetMessageHistoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMessageHistoryResponse.ProtoReflect.Descriptor instead.
-func (*GetMessageHistoryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ContentNode.ProtoReflect.Descriptor instead.
+func (*ContentNode) Descriptor() ([]byte, []int) {
 	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetMessageHistoryResponse) GetMessages() []*Message {
+func (x *ContentNode) GetType() ContentNodeType {
 	if x != nil {
-		return x.Messages
+		return x.Type
+	}
+	return ContentNodeType_CONTENT_NODE_TYPE_UNSPECIFIED
+}
+
+func (x *ContentNode) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *ContentNode) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ContentNode) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *ContentNode) GetChildren() []*ContentNode {
+	if x != nil {
+		return x.Children
 	}
 	return nil
 }
 
-type GetMessageRequest struct {
+type Reaction struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Channel       *TextChannelRef        `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
-	MessageId     string                 `protobuf:"bytes,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Emoji         string                 `protobuf:"bytes,1,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	ReactedByMe   bool                   `protobuf:"varint,3,opt,name=reacted_by_me,json=reactedByMe,proto3" json:"reacted_by_me,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMessageRequest) Reset() {
-	*x = GetMessageRequest{}
+func (x *Reaction) Reset() {
+	*x = Reaction{}
 	mi := &file_confa_chat_v1_service_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMessageRequest) String() string {
+func (x *Reaction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMessageRequest) ProtoMessage() {}
+func (*Reaction) ProtoMessage() {}
 
-func (x *GetMessageRequest) ProtoReflect() protoreflect.Message {
+func (x *Reaction) ProtoReflect() protoreflect.Message {
 	mi := &file_confa_chat_v1_service_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -451,47 +813,3848 @@ func (x *GetMessageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMessageRequest.ProtoReflect.Descriptor instead.
-func (*GetMessageRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Reaction.ProtoReflect.Descriptor instead.
+func (*Reaction) Descriptor() ([]byte, []int) {
 	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *GetMessageRequest) GetChannel() *TextChannelRef {
+func (x *Reaction) GetEmoji() string {
 	if x != nil {
-		return x.Channel
+		return x.Emoji
 	}
-	return nil
+	return ""
 }
 
-func (x *GetMessageRequest) GetMessageId() string {
+func (x *Reaction) GetCount() int32 {
 	if x != nil {
-		return x.MessageId
+		return x.Count
 	}
-	return ""
+	return 0
 }
 
-type GetMessageResponse struct {
+func (x *Reaction) GetReactedByMe() bool {
+	if x != nil {
+		return x.ReactedByMe
+	}
+	return false
+}
+
+type Attachment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AttachmentId  string                 `protobuf:"bytes,1,opt,name=attachment_id,json=attachmentId,proto3" json:"attachment_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Attachment) Reset() {
+	*x = Attachment{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attachment) ProtoMessage() {}
+
+func (x *Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
+func (*Attachment) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Attachment) GetAttachmentId() string {
+	if x != nil {
+		return x.AttachmentId
+	}
+	return ""
+}
+
+func (x *Attachment) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Attachment) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type GetMessageHistoryRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Channel *TextChannelRef        `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	From    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	Count   int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	// Types that are valid to be assigned to Anchor:
+	//
+	//	*GetMessageHistoryRequest_BeforeId
+	//	*GetMessageHistoryRequest_AfterId
+	//	*GetMessageHistoryRequest_AroundId
+	Anchor        isGetMessageHistoryRequest_Anchor `protobuf_oneof:"anchor"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMessageHistoryRequest) Reset() {
+	*x = GetMessageHistoryRequest{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMessageHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMessageHistoryRequest) ProtoMessage() {}
+
+func (x *GetMessageHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMessageHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetMessageHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetMessageHistoryRequest) GetChannel() *TextChannelRef {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+func (x *GetMessageHistoryRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetMessageHistoryRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GetMessageHistoryRequest) GetAnchor() isGetMessageHistoryRequest_Anchor {
+	if x != nil {
+		return x.Anchor
+	}
+	return nil
+}
+
+func (x *GetMessageHistoryRequest) GetBeforeId() string {
+	if x != nil {
+		if x, ok := x.Anchor.(*GetMessageHistoryRequest_BeforeId); ok {
+			return x.BeforeId
+		}
+	}
+	return ""
+}
+
+func (x *GetMessageHistoryRequest) GetAfterId() string {
+	if x != nil {
+		if x, ok := x.Anchor.(*GetMessageHistoryRequest_AfterId); ok {
+			return x.AfterId
+		}
+	}
+	return ""
+}
+
+func (x *GetMessageHistoryRequest) GetAroundId() string {
+	if x != nil {
+		if x, ok := x.Anchor.(*GetMessageHistoryRequest_AroundId); ok {
+			return x.AroundId
+		}
+	}
+	return ""
+}
+
+type isGetMessageHistoryRequest_Anchor interface {
+	isGetMessageHistoryRequest_Anchor()
+}
+
+type GetMessageHistoryRequest_BeforeId struct {
+	BeforeId string `protobuf:"bytes,4,opt,name=before_id,json=beforeId,proto3,oneof"`
+}
+
+type GetMessageHistoryRequest_AfterId struct {
+	AfterId string `protobuf:"bytes,5,opt,name=after_id,json=afterId,proto3,oneof"`
+}
+
+type GetMessageHistoryRequest_AroundId struct {
+	AroundId string `protobuf:"bytes,6,opt,name=around_id,json=aroundId,proto3,oneof"`
+}
+
+func (*GetMessageHistoryRequest_BeforeId) isGetMessageHistoryRequest_Anchor() {}
+
+func (*GetMessageHistoryRequest_AfterId) isGetMessageHistoryRequest_Anchor() {}
+
+func (*GetMessageHistoryRequest_AroundId) isGetMessageHistoryRequest_Anchor() {}
+
+type GetMessageHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Messages      []*Message             `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	HasMoreBefore bool                   `protobuf:"varint,2,opt,name=has_more_before,json=hasMoreBefore,proto3" json:"has_more_before,omitempty"`
+	HasMoreAfter  bool                   `protobuf:"varint,3,opt,name=has_more_after,json=hasMoreAfter,proto3" json:"has_more_after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMessageHistoryResponse) Reset() {
+	*x = GetMessageHistoryResponse{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMessageHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMessageHistoryResponse) ProtoMessage() {}
+
+func (x *GetMessageHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMessageHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetMessageHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetMessageHistoryResponse) GetMessages() []*Message {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *GetMessageHistoryResponse) GetHasMoreBefore() bool {
+	if x != nil {
+		return x.HasMoreBefore
+	}
+	return false
+}
+
+func (x *GetMessageHistoryResponse) GetHasMoreAfter() bool {
+	if x != nil {
+		return x.HasMoreAfter
+	}
+	return false
+}
+
+type GetMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       *TextChannelRef        `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	MessageId     string                 `protobuf:"bytes,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMessageRequest) Reset() {
+	*x = GetMessageRequest{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMessageRequest) ProtoMessage() {}
+
+func (x *GetMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMessageRequest.ProtoReflect.Descriptor instead.
+func (*GetMessageRequest) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetMessageRequest) GetChannel() *TextChannelRef {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+func (x *GetMessageRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+type GetMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *Message               `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMessageResponse) Reset() {
+	*x = GetMessageResponse{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMessageResponse) ProtoMessage() {}
+
+func (x *GetMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMessageResponse.ProtoReflect.Descriptor instead.
+func (*GetMessageResponse) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetMessageResponse) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type StreamNewMessagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       *TextChannelRef        `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	LastMessageId string                 `protobuf:"bytes,2,opt,name=last_message_id,json=lastMessageId,proto3" json:"last_message_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamNewMessagesRequest) Reset() {
+	*x = StreamNewMessagesRequest{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamNewMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamNewMessagesRequest) ProtoMessage() {}
+
+func (x *StreamNewMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamNewMessagesRequest.ProtoReflect.Descriptor instead.
+func (*StreamNewMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *StreamNewMessagesRequest) GetChannel() *TextChannelRef {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+func (x *StreamNewMessagesRequest) GetLastMessageId() string {
+	if x != nil {
+		return x.LastMessageId
+	}
+	return ""
+}
+
+type StreamNewMessagesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: Marked as deprecated in confa/chat/v1/service.proto.
+	MessageId string `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	// Deprecated: Marked as deprecated in confa/chat/v1/service.proto.
+	Type          MessageEventType `protobuf:"varint,2,opt,name=type,proto3,enum=confa.chat.v1.MessageEventType" json:"type,omitempty"`
+	Event         *MessageEvent    `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamNewMessagesResponse) Reset() {
+	*x = StreamNewMessagesResponse{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamNewMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamNewMessagesResponse) ProtoMessage() {}
+
+func (x *StreamNewMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamNewMessagesResponse.ProtoReflect.Descriptor instead.
+func (*StreamNewMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{14}
+}
+
+// Deprecated: Marked as deprecated in confa/chat/v1/service.proto.
+func (x *StreamNewMessagesResponse) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in confa/chat/v1/service.proto.
+func (x *StreamNewMessagesResponse) GetType() MessageEventType {
+	if x != nil {
+		return x.Type
+	}
+	return MessageEventType_MESSAGE_EVENT_TYPE_CREATED
+}
+
+func (x *StreamNewMessagesResponse) GetEvent() *MessageEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+type MessageEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Event:
+	//
+	//	*MessageEvent_Created
+	//	*MessageEvent_Edited
+	//	*MessageEvent_Deleted
+	//	*MessageEvent_ThreadUpdated
+	//	*MessageEvent_Reaction
+	//	*MessageEvent_Typing
+	//	*MessageEvent_Pin
+	Event         isMessageEvent_Event `protobuf_oneof:"event"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MessageEvent) Reset() {
+	*x = MessageEvent{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageEvent) ProtoMessage() {}
+
+func (x *MessageEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageEvent.ProtoReflect.Descriptor instead.
+func (*MessageEvent) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *MessageEvent) GetEvent() isMessageEvent_Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *MessageEvent) GetCreated() *MessageCreatedEvent {
+	if x != nil {
+		if x, ok := x.Event.(*MessageEvent_Created); ok {
+			return x.Created
+		}
+	}
+	return nil
+}
+
+func (x *MessageEvent) GetEdited() *MessageEditedEvent {
+	if x != nil {
+		if x, ok := x.Event.(*MessageEvent_Edited); ok {
+			return x.Edited
+		}
+	}
+	return nil
+}
+
+func (x *MessageEvent) GetDeleted() *MessageDeletedEvent {
+	if x != nil {
+		if x, ok := x.Event.(*MessageEvent_Deleted); ok {
+			return x.Deleted
+		}
+	}
+	return nil
+}
+
+func (x *MessageEvent) GetThreadUpdated() *MessageThreadUpdatedEvent {
+	if x != nil {
+		if x, ok := x.Event.(*MessageEvent_ThreadUpdated); ok {
+			return x.ThreadUpdated
+		}
+	}
+	return nil
+}
+
+func (x *MessageEvent) GetReaction() *MessageReactionEvent {
+	if x != nil {
+		if x, ok := x.Event.(*MessageEvent_Reaction); ok {
+			return x.Reaction
+		}
+	}
+	return nil
+}
+
+func (x *MessageEvent) GetTyping() *TypingEvent {
+	if x != nil {
+		if x, ok := x.Event.(*MessageEvent_Typing); ok {
+			return x.Typing
+		}
+	}
+	return nil
+}
+
+func (x *MessageEvent) GetPin() *MessagePinEvent {
+	if x != nil {
+		if x, ok := x.Event.(*MessageEvent_Pin); ok {
+			return x.Pin
+		}
+	}
+	return nil
+}
+
+type isMessageEvent_Event interface {
+	isMessageEvent_Event()
+}
+
+type MessageEvent_Created struct {
+	Created *MessageCreatedEvent `protobuf:"bytes,1,opt,name=created,proto3,oneof"`
+}
+
+type MessageEvent_Edited struct {
+	Edited *MessageEditedEvent `protobuf:"bytes,2,opt,name=edited,proto3,oneof"`
+}
+
+type MessageEvent_Deleted struct {
+	Deleted *MessageDeletedEvent `protobuf:"bytes,3,opt,name=deleted,proto3,oneof"`
+}
+
+type MessageEvent_ThreadUpdated struct {
+	ThreadUpdated *MessageThreadUpdatedEvent `protobuf:"bytes,4,opt,name=thread_updated,json=threadUpdated,proto3,oneof"`
+}
+
+type MessageEvent_Reaction struct {
+	Reaction *MessageReactionEvent `protobuf:"bytes,5,opt,name=reaction,proto3,oneof"`
+}
+
+type MessageEvent_Typing struct {
+	Typing *TypingEvent `protobuf:"bytes,6,opt,name=typing,proto3,oneof"`
+}
+
+type MessageEvent_Pin struct {
+	Pin *MessagePinEvent `protobuf:"bytes,7,opt,name=pin,proto3,oneof"`
+}
+
+func (*MessageEvent_Created) isMessageEvent_Event() {}
+
+func (*MessageEvent_Edited) isMessageEvent_Event() {}
+
+func (*MessageEvent_Deleted) isMessageEvent_Event() {}
+
+func (*MessageEvent_ThreadUpdated) isMessageEvent_Event() {}
+
+func (*MessageEvent_Reaction) isMessageEvent_Event() {}
+
+func (*MessageEvent_Typing) isMessageEvent_Event() {}
+
+func (*MessageEvent_Pin) isMessageEvent_Event() {}
+
+type MessageCreatedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *Message               `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MessageCreatedEvent) Reset() {
+	*x = MessageCreatedEvent{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageCreatedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageCreatedEvent) ProtoMessage() {}
+
+func (x *MessageCreatedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageCreatedEvent.ProtoReflect.Descriptor instead.
+func (*MessageCreatedEvent) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *MessageCreatedEvent) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type MessageEditedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *Message               `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MessageEditedEvent) Reset() {
+	*x = MessageEditedEvent{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageEditedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageEditedEvent) ProtoMessage() {}
+
+func (x *MessageEditedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageEditedEvent.ProtoReflect.Descriptor instead.
+func (*MessageEditedEvent) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *MessageEditedEvent) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type MessageDeletedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *Message               `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MessageDeletedEvent) Reset() {
+	*x = MessageDeletedEvent{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageDeletedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageDeletedEvent) ProtoMessage() {}
+
+func (x *MessageDeletedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageDeletedEvent.ProtoReflect.Descriptor instead.
+func (*MessageDeletedEvent) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *MessageDeletedEvent) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type MessageThreadUpdatedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *Message               `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MessageThreadUpdatedEvent) Reset() {
+	*x = MessageThreadUpdatedEvent{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageThreadUpdatedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageThreadUpdatedEvent) ProtoMessage() {}
+
+func (x *MessageThreadUpdatedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageThreadUpdatedEvent.ProtoReflect.Descriptor instead.
+func (*MessageThreadUpdatedEvent) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *MessageThreadUpdatedEvent) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type MessageReactionEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Emoji         string                 `protobuf:"bytes,3,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	Added         bool                   `protobuf:"varint,4,opt,name=added,proto3" json:"added,omitempty"`
+	Count         int32                  `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MessageReactionEvent) Reset() {
+	*x = MessageReactionEvent{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageReactionEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageReactionEvent) ProtoMessage() {}
+
+func (x *MessageReactionEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageReactionEvent.ProtoReflect.Descriptor instead.
+func (*MessageReactionEvent) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *MessageReactionEvent) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *MessageReactionEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *MessageReactionEvent) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+func (x *MessageReactionEvent) GetAdded() bool {
+	if x != nil {
+		return x.Added
+	}
+	return false
+}
+
+func (x *MessageReactionEvent) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type UploadAttachmentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*UploadAttachmentRequest_Info
+	//	*UploadAttachmentRequest_Data
+	Payload       isUploadAttachmentRequest_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAttachmentRequest) Reset() {
+	*x = UploadAttachmentRequest{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAttachmentRequest) ProtoMessage() {}
+
+func (x *UploadAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*UploadAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UploadAttachmentRequest) GetPayload() isUploadAttachmentRequest_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *UploadAttachmentRequest) GetInfo() *AttachmentUploadInfo {
+	if x != nil {
+		if x, ok := x.Payload.(*UploadAttachmentRequest_Info); ok {
+			return x.Info
+		}
+	}
+	return nil
+}
+
+func (x *UploadAttachmentRequest) GetData() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*UploadAttachmentRequest_Data); ok {
+			return x.Data
+		}
+	}
+	return nil
+}
+
+type isUploadAttachmentRequest_Payload interface {
+	isUploadAttachmentRequest_Payload()
+}
+
+type UploadAttachmentRequest_Info struct {
+	Info *AttachmentUploadInfo `protobuf:"bytes,1,opt,name=info,proto3,oneof"`
+}
+
+type UploadAttachmentRequest_Data struct {
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3,oneof"`
+}
+
+func (*UploadAttachmentRequest_Info) isUploadAttachmentRequest_Payload() {}
+
+func (*UploadAttachmentRequest_Data) isUploadAttachmentRequest_Payload() {}
+
+type AttachmentUploadInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachmentUploadInfo) Reset() {
+	*x = AttachmentUploadInfo{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachmentUploadInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachmentUploadInfo) ProtoMessage() {}
+
+func (x *AttachmentUploadInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachmentUploadInfo.ProtoReflect.Descriptor instead.
+func (*AttachmentUploadInfo) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AttachmentUploadInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UploadAttachmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AttachmentId  string                 `protobuf:"bytes,1,opt,name=attachment_id,json=attachmentId,proto3" json:"attachment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAttachmentResponse) Reset() {
+	*x = UploadAttachmentResponse{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAttachmentResponse) ProtoMessage() {}
+
+func (x *UploadAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*UploadAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UploadAttachmentResponse) GetAttachmentId() string {
+	if x != nil {
+		return x.AttachmentId
+	}
+	return ""
+}
+
+type EditMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       *TextChannelRef        `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	MessageId     string                 `protobuf:"bytes,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditMessageRequest) Reset() {
+	*x = EditMessageRequest{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditMessageRequest) ProtoMessage() {}
+
+func (x *EditMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditMessageRequest.ProtoReflect.Descriptor instead.
+func (*EditMessageRequest) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *EditMessageRequest) GetChannel() *TextChannelRef {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+func (x *EditMessageRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *EditMessageRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type EditMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *Message               `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditMessageResponse) Reset() {
+	*x = EditMessageResponse{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditMessageResponse) ProtoMessage() {}
+
+func (x *EditMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditMessageResponse.ProtoReflect.Descriptor instead.
+func (*EditMessageResponse) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *EditMessageResponse) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type MessageRevision struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RevisionId    string                 `protobuf:"bytes,1,opt,name=revision_id,json=revisionId,proto3" json:"revision_id,omitempty"`
+	MessageId     string                 `protobuf:"bytes,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MessageRevision) Reset() {
+	*x = MessageRevision{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageRevision) ProtoMessage() {}
+
+func (x *MessageRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageRevision.ProtoReflect.Descriptor instead.
+func (*MessageRevision) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *MessageRevision) GetRevisionId() string {
+	if x != nil {
+		return x.RevisionId
+	}
+	return ""
+}
+
+func (x *MessageRevision) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *MessageRevision) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *MessageRevision) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type ListMessageRevisionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       *TextChannelRef        `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	MessageId     string                 `protobuf:"bytes,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMessageRevisionsRequest) Reset() {
+	*x = ListMessageRevisionsRequest{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMessageRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMessageRevisionsRequest) ProtoMessage() {}
+
+func (x *ListMessageRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMessageRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListMessageRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListMessageRevisionsRequest) GetChannel() *TextChannelRef {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+func (x *ListMessageRevisionsRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+type ListMessageRevisionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revisions     []*MessageRevision     `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMessageRevisionsResponse) Reset() {
+	*x = ListMessageRevisionsResponse{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMessageRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMessageRevisionsResponse) ProtoMessage() {}
+
+func (x *ListMessageRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMessageRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*ListMessageRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListMessageRevisionsResponse) GetRevisions() []*MessageRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+type DeleteMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       *TextChannelRef        `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	MessageId     string                 `protobuf:"bytes,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMessageRequest) Reset() {
+	*x = DeleteMessageRequest{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMessageRequest) ProtoMessage() {}
+
+func (x *DeleteMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMessageRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMessageRequest) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *DeleteMessageRequest) GetChannel() *TextChannelRef {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+func (x *DeleteMessageRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+type DeleteMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMessageResponse) Reset() {
+	*x = DeleteMessageResponse{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMessageResponse) ProtoMessage() {}
+
+func (x *DeleteMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMessageResponse.ProtoReflect.Descriptor instead.
+func (*DeleteMessageResponse) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{30}
+}
+
+type ListThreadMessagesRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Channel         *TextChannelRef        `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	ParentMessageId string                 `protobuf:"bytes,2,opt,name=parent_message_id,json=parentMessageId,proto3" json:"parent_message_id,omitempty"`
+	AfterMessageId  string                 `protobuf:"bytes,3,opt,name=after_message_id,json=afterMessageId,proto3" json:"after_message_id,omitempty"`
+	Count           int32                  `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListThreadMessagesRequest) Reset() {
+	*x = ListThreadMessagesRequest{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListThreadMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListThreadMessagesRequest) ProtoMessage() {}
+
+func (x *ListThreadMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListThreadMessagesRequest.ProtoReflect.Descriptor instead.
+func (*ListThreadMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListThreadMessagesRequest) GetChannel() *TextChannelRef {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+func (x *ListThreadMessagesRequest) GetParentMessageId() string {
+	if x != nil {
+		return x.ParentMessageId
+	}
+	return ""
+}
+
+func (x *ListThreadMessagesRequest) GetAfterMessageId() string {
+	if x != nil {
+		return x.AfterMessageId
+	}
+	return ""
+}
+
+func (x *ListThreadMessagesRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ListThreadMessagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Messages      []*Message             `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	HasMore       bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListThreadMessagesResponse) Reset() {
+	*x = ListThreadMessagesResponse{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListThreadMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListThreadMessagesResponse) ProtoMessage() {}
+
+func (x *ListThreadMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListThreadMessagesResponse.ProtoReflect.Descriptor instead.
+func (*ListThreadMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListThreadMessagesResponse) GetMessages() []*Message {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *ListThreadMessagesResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+type StreamThreadMessagesRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Channel         *TextChannelRef        `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	ParentMessageId string                 `protobuf:"bytes,2,opt,name=parent_message_id,json=parentMessageId,proto3" json:"parent_message_id,omitempty"`
+	LastMessageId   string                 `protobuf:"bytes,3,opt,name=last_message_id,json=lastMessageId,proto3" json:"last_message_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *StreamThreadMessagesRequest) Reset() {
+	*x = StreamThreadMessagesRequest{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamThreadMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamThreadMessagesRequest) ProtoMessage() {}
+
+func (x *StreamThreadMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamThreadMessagesRequest.ProtoReflect.Descriptor instead.
+func (*StreamThreadMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *StreamThreadMessagesRequest) GetChannel() *TextChannelRef {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+func (x *StreamThreadMessagesRequest) GetParentMessageId() string {
+	if x != nil {
+		return x.ParentMessageId
+	}
+	return ""
+}
+
+func (x *StreamThreadMessagesRequest) GetLastMessageId() string {
+	if x != nil {
+		return x.LastMessageId
+	}
+	return ""
+}
+
+type AddReactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       *TextChannelRef        `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	MessageId     string                 `protobuf:"bytes,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Emoji         string                 `protobuf:"bytes,3,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddReactionRequest) Reset() {
+	*x = AddReactionRequest{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddReactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddReactionRequest) ProtoMessage() {}
+
+func (x *AddReactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddReactionRequest.ProtoReflect.Descriptor instead.
+func (*AddReactionRequest) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *AddReactionRequest) GetChannel() *TextChannelRef {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+func (x *AddReactionRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *AddReactionRequest) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+type AddReactionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddReactionResponse) Reset() {
+	*x = AddReactionResponse{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddReactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddReactionResponse) ProtoMessage() {}
+
+func (x *AddReactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddReactionResponse.ProtoReflect.Descriptor instead.
+func (*AddReactionResponse) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{35}
+}
+
+type RemoveReactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       *TextChannelRef        `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	MessageId     string                 `protobuf:"bytes,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Emoji         string                 `protobuf:"bytes,3,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveReactionRequest) Reset() {
+	*x = RemoveReactionRequest{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveReactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveReactionRequest) ProtoMessage() {}
+
+func (x *RemoveReactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveReactionRequest.ProtoReflect.Descriptor instead.
+func (*RemoveReactionRequest) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *RemoveReactionRequest) GetChannel() *TextChannelRef {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+func (x *RemoveReactionRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *RemoveReactionRequest) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+type RemoveReactionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveReactionResponse) Reset() {
+	*x = RemoveReactionResponse{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveReactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveReactionResponse) ProtoMessage() {}
+
+func (x *RemoveReactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveReactionResponse.ProtoReflect.Descriptor instead.
+func (*RemoveReactionResponse) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{37}
+}
+
+type SearchMessagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	ServerId      string                 `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	ChannelId     string                 `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	AuthorId      string                 `protobuf:"bytes,4,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=to,proto3" json:"to,omitempty"`
+	HasAttachment *bool                  `protobuf:"varint,7,opt,name=has_attachment,json=hasAttachment,proto3,oneof" json:"has_attachment,omitempty"`
+	Count         int32                  `protobuf:"varint,8,opt,name=count,proto3" json:"count,omitempty"`
+	Offset        int32                  `protobuf:"varint,9,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchMessagesRequest) Reset() {
+	*x = SearchMessagesRequest{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchMessagesRequest) ProtoMessage() {}
+
+func (x *SearchMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchMessagesRequest.ProtoReflect.Descriptor instead.
+func (*SearchMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *SearchMessagesRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchMessagesRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *SearchMessagesRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *SearchMessagesRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *SearchMessagesRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *SearchMessagesRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *SearchMessagesRequest) GetHasAttachment() bool {
+	if x != nil && x.HasAttachment != nil {
+		return *x.HasAttachment
+	}
+	return false
+}
+
+func (x *SearchMessagesRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *SearchMessagesRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type SearchMessagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*SearchResult        `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	HasMore       bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchMessagesResponse) Reset() {
+	*x = SearchMessagesResponse{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchMessagesResponse) ProtoMessage() {}
+
+func (x *SearchMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchMessagesResponse.ProtoReflect.Descriptor instead.
+func (*SearchMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SearchMessagesResponse) GetResults() []*SearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *SearchMessagesResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+type SearchResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       *TextChannelRef        `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Message       *Message               `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Snippet       string                 `protobuf:"bytes,3,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	Rank          float32                `protobuf:"fixed32,4,opt,name=rank,proto3" json:"rank,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchResult) Reset() {
+	*x = SearchResult{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResult) ProtoMessage() {}
+
+func (x *SearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResult.ProtoReflect.Descriptor instead.
+func (*SearchResult) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SearchResult) GetChannel() *TextChannelRef {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+func (x *SearchResult) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *SearchResult) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+func (x *SearchResult) GetRank() float32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+type Mention struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MentionId     string                 `protobuf:"bytes,1,opt,name=mention_id,json=mentionId,proto3" json:"mention_id,omitempty"`
+	Channel       *TextChannelRef        `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	Message       *Message               `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Kind          MentionKind            `protobuf:"varint,4,opt,name=kind,proto3,enum=confa.chat.v1.MentionKind" json:"kind,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Acknowledged  bool                   `protobuf:"varint,6,opt,name=acknowledged,proto3" json:"acknowledged,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Mention) Reset() {
+	*x = Mention{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Mention) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Mention) ProtoMessage() {}
+
+func (x *Mention) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Mention.ProtoReflect.Descriptor instead.
+func (*Mention) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *Mention) GetMentionId() string {
+	if x != nil {
+		return x.MentionId
+	}
+	return ""
+}
+
+func (x *Mention) GetChannel() *TextChannelRef {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+func (x *Mention) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *Mention) GetKind() MentionKind {
+	if x != nil {
+		return x.Kind
+	}
+	return MentionKind_MENTION_KIND_USER
+}
+
+func (x *Mention) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *Mention) GetAcknowledged() bool {
+	if x != nil {
+		return x.Acknowledged
+	}
+	return false
+}
+
+type ListMentionsRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	UnacknowledgedOnly bool                   `protobuf:"varint,1,opt,name=unacknowledged_only,json=unacknowledgedOnly,proto3" json:"unacknowledged_only,omitempty"`
+	BeforeMentionId    string                 `protobuf:"bytes,2,opt,name=before_mention_id,json=beforeMentionId,proto3" json:"before_mention_id,omitempty"`
+	Count              int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ListMentionsRequest) Reset() {
+	*x = ListMentionsRequest{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMentionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMentionsRequest) ProtoMessage() {}
+
+func (x *ListMentionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMentionsRequest.ProtoReflect.Descriptor instead.
+func (*ListMentionsRequest) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListMentionsRequest) GetUnacknowledgedOnly() bool {
+	if x != nil {
+		return x.UnacknowledgedOnly
+	}
+	return false
+}
+
+func (x *ListMentionsRequest) GetBeforeMentionId() string {
+	if x != nil {
+		return x.BeforeMentionId
+	}
+	return ""
+}
+
+func (x *ListMentionsRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ListMentionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mentions      []*Mention             `protobuf:"bytes,1,rep,name=mentions,proto3" json:"mentions,omitempty"`
+	HasMore       bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMentionsResponse) Reset() {
+	*x = ListMentionsResponse{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMentionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMentionsResponse) ProtoMessage() {}
+
+func (x *ListMentionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMentionsResponse.ProtoReflect.Descriptor instead.
+func (*ListMentionsResponse) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListMentionsResponse) GetMentions() []*Mention {
+	if x != nil {
+		return x.Mentions
+	}
+	return nil
+}
+
+func (x *ListMentionsResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+type AcknowledgeMentionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MentionIds    []string               `protobuf:"bytes,1,rep,name=mention_ids,json=mentionIds,proto3" json:"mention_ids,omitempty"`
+	All           bool                   `protobuf:"varint,2,opt,name=all,proto3" json:"all,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcknowledgeMentionsRequest) Reset() {
+	*x = AcknowledgeMentionsRequest{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcknowledgeMentionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcknowledgeMentionsRequest) ProtoMessage() {}
+
+func (x *AcknowledgeMentionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcknowledgeMentionsRequest.ProtoReflect.Descriptor instead.
+func (*AcknowledgeMentionsRequest) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *AcknowledgeMentionsRequest) GetMentionIds() []string {
+	if x != nil {
+		return x.MentionIds
+	}
+	return nil
+}
+
+func (x *AcknowledgeMentionsRequest) GetAll() bool {
+	if x != nil {
+		return x.All
+	}
+	return false
+}
+
+type AcknowledgeMentionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcknowledgeMentionsResponse) Reset() {
+	*x = AcknowledgeMentionsResponse{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcknowledgeMentionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcknowledgeMentionsResponse) ProtoMessage() {}
+
+func (x *AcknowledgeMentionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcknowledgeMentionsResponse.ProtoReflect.Descriptor instead.
+func (*AcknowledgeMentionsResponse) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{45}
+}
+
+type StreamMentionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamMentionsRequest) Reset() {
+	*x = StreamMentionsRequest{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamMentionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamMentionsRequest) ProtoMessage() {}
+
+func (x *StreamMentionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamMentionsRequest.ProtoReflect.Descriptor instead.
+func (*StreamMentionsRequest) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{46}
+}
+
+type StreamMentionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mention       *Mention               `protobuf:"bytes,1,opt,name=mention,proto3" json:"mention,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamMentionsResponse) Reset() {
+	*x = StreamMentionsResponse{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamMentionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamMentionsResponse) ProtoMessage() {}
+
+func (x *StreamMentionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamMentionsResponse.ProtoReflect.Descriptor instead.
+func (*StreamMentionsResponse) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *StreamMentionsResponse) GetMention() *Mention {
+	if x != nil {
+		return x.Mention
+	}
+	return nil
+}
+
+type ReadState struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Channel           *TextChannelRef        `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	LastReadMessageId string                 `protobuf:"bytes,2,opt,name=last_read_message_id,json=lastReadMessageId,proto3" json:"last_read_message_id,omitempty"`
+	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ReadState) Reset() {
+	*x = ReadState{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadState) ProtoMessage() {}
+
+func (x *ReadState) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadState.ProtoReflect.Descriptor instead.
+func (*ReadState) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ReadState) GetChannel() *TextChannelRef {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+func (x *ReadState) GetLastReadMessageId() string {
+	if x != nil {
+		return x.LastReadMessageId
+	}
+	return ""
+}
+
+func (x *ReadState) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type MarkChannelReadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       *TextChannelRef        `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	MessageId     string                 `protobuf:"bytes,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkChannelReadRequest) Reset() {
+	*x = MarkChannelReadRequest{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkChannelReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkChannelReadRequest) ProtoMessage() {}
+
+func (x *MarkChannelReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkChannelReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkChannelReadRequest) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *MarkChannelReadRequest) GetChannel() *TextChannelRef {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+func (x *MarkChannelReadRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+type MarkChannelReadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReadState     *ReadState             `protobuf:"bytes,1,opt,name=read_state,json=readState,proto3" json:"read_state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkChannelReadResponse) Reset() {
+	*x = MarkChannelReadResponse{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkChannelReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkChannelReadResponse) ProtoMessage() {}
+
+func (x *MarkChannelReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkChannelReadResponse.ProtoReflect.Descriptor instead.
+func (*MarkChannelReadResponse) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *MarkChannelReadResponse) GetReadState() *ReadState {
+	if x != nil {
+		return x.ReadState
+	}
+	return nil
+}
+
+type StreamReadStateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamReadStateRequest) Reset() {
+	*x = StreamReadStateRequest{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamReadStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamReadStateRequest) ProtoMessage() {}
+
+func (x *StreamReadStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamReadStateRequest.ProtoReflect.Descriptor instead.
+func (*StreamReadStateRequest) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{51}
+}
+
+type StreamReadStateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReadState     *ReadState             `protobuf:"bytes,1,opt,name=read_state,json=readState,proto3" json:"read_state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamReadStateResponse) Reset() {
+	*x = StreamReadStateResponse{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamReadStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamReadStateResponse) ProtoMessage() {}
+
+func (x *StreamReadStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamReadStateResponse.ProtoReflect.Descriptor instead.
+func (*StreamReadStateResponse) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *StreamReadStateResponse) GetReadState() *ReadState {
+	if x != nil {
+		return x.ReadState
+	}
+	return nil
+}
+
+type TypingEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Typing        bool                   `protobuf:"varint,2,opt,name=typing,proto3" json:"typing,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TypingEvent) Reset() {
+	*x = TypingEvent{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TypingEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TypingEvent) ProtoMessage() {}
+
+func (x *TypingEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TypingEvent.ProtoReflect.Descriptor instead.
+func (*TypingEvent) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *TypingEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TypingEvent) GetTyping() bool {
+	if x != nil {
+		return x.Typing
+	}
+	return false
+}
+
+func (x *TypingEvent) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type SetTypingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       *TextChannelRef        `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Typing        bool                   `protobuf:"varint,2,opt,name=typing,proto3" json:"typing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTypingRequest) Reset() {
+	*x = SetTypingRequest{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTypingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTypingRequest) ProtoMessage() {}
+
+func (x *SetTypingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTypingRequest.ProtoReflect.Descriptor instead.
+func (*SetTypingRequest) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *SetTypingRequest) GetChannel() *TextChannelRef {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+func (x *SetTypingRequest) GetTyping() bool {
+	if x != nil {
+		return x.Typing
+	}
+	return false
+}
+
+type SetTypingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTypingResponse) Reset() {
+	*x = SetTypingResponse{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTypingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTypingResponse) ProtoMessage() {}
+
+func (x *SetTypingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTypingResponse.ProtoReflect.Descriptor instead.
+func (*SetTypingResponse) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{55}
+}
+
+type MessagePinEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *Message               `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Pinned        bool                   `protobuf:"varint,2,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	PinnedBy      string                 `protobuf:"bytes,3,opt,name=pinned_by,json=pinnedBy,proto3" json:"pinned_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MessagePinEvent) Reset() {
+	*x = MessagePinEvent{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessagePinEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessagePinEvent) ProtoMessage() {}
+
+func (x *MessagePinEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessagePinEvent.ProtoReflect.Descriptor instead.
+func (*MessagePinEvent) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *MessagePinEvent) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *MessagePinEvent) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+func (x *MessagePinEvent) GetPinnedBy() string {
+	if x != nil {
+		return x.PinnedBy
+	}
+	return ""
+}
+
+type PinnedMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *Message               `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	PinnedBy      string                 `protobuf:"bytes,2,opt,name=pinned_by,json=pinnedBy,proto3" json:"pinned_by,omitempty"`
+	PinnedAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=pinned_at,json=pinnedAt,proto3" json:"pinned_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PinnedMessage) Reset() {
+	*x = PinnedMessage{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PinnedMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinnedMessage) ProtoMessage() {}
+
+func (x *PinnedMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinnedMessage.ProtoReflect.Descriptor instead.
+func (*PinnedMessage) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *PinnedMessage) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *PinnedMessage) GetPinnedBy() string {
+	if x != nil {
+		return x.PinnedBy
+	}
+	return ""
+}
+
+func (x *PinnedMessage) GetPinnedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PinnedAt
+	}
+	return nil
+}
+
+type PinMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       *TextChannelRef        `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	MessageId     string                 `protobuf:"bytes,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PinMessageRequest) Reset() {
+	*x = PinMessageRequest{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PinMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinMessageRequest) ProtoMessage() {}
+
+func (x *PinMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinMessageRequest.ProtoReflect.Descriptor instead.
+func (*PinMessageRequest) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *PinMessageRequest) GetChannel() *TextChannelRef {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+func (x *PinMessageRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+type PinMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PinMessageResponse) Reset() {
+	*x = PinMessageResponse{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PinMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinMessageResponse) ProtoMessage() {}
+
+func (x *PinMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinMessageResponse.ProtoReflect.Descriptor instead.
+func (*PinMessageResponse) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{59}
+}
+
+type UnpinMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       *TextChannelRef        `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	MessageId     string                 `protobuf:"bytes,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnpinMessageRequest) Reset() {
+	*x = UnpinMessageRequest{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnpinMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpinMessageRequest) ProtoMessage() {}
+
+func (x *UnpinMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpinMessageRequest.ProtoReflect.Descriptor instead.
+func (*UnpinMessageRequest) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *UnpinMessageRequest) GetChannel() *TextChannelRef {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+func (x *UnpinMessageRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+type UnpinMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnpinMessageResponse) Reset() {
+	*x = UnpinMessageResponse{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnpinMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpinMessageResponse) ProtoMessage() {}
+
+func (x *UnpinMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpinMessageResponse.ProtoReflect.Descriptor instead.
+func (*UnpinMessageResponse) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{61}
+}
+
+type ListPinnedMessagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       *TextChannelRef        `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPinnedMessagesRequest) Reset() {
+	*x = ListPinnedMessagesRequest{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPinnedMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPinnedMessagesRequest) ProtoMessage() {}
+
+func (x *ListPinnedMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPinnedMessagesRequest.ProtoReflect.Descriptor instead.
+func (*ListPinnedMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ListPinnedMessagesRequest) GetChannel() *TextChannelRef {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+type ListPinnedMessagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pins          []*PinnedMessage       `protobuf:"bytes,1,rep,name=pins,proto3" json:"pins,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPinnedMessagesResponse) Reset() {
+	*x = ListPinnedMessagesResponse{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPinnedMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPinnedMessagesResponse) ProtoMessage() {}
+
+func (x *ListPinnedMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPinnedMessagesResponse.ProtoReflect.Descriptor instead.
+func (*ListPinnedMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ListPinnedMessagesResponse) GetPins() []*PinnedMessage {
+	if x != nil {
+		return x.Pins
+	}
+	return nil
+}
+
+type ScheduledMessage struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ScheduledMessageId string                 `protobuf:"bytes,1,opt,name=scheduled_message_id,json=scheduledMessageId,proto3" json:"scheduled_message_id,omitempty"`
+	Channel            *TextChannelRef        `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	Content            string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	AttachmentIds      []string               `protobuf:"bytes,4,rep,name=attachment_ids,json=attachmentIds,proto3" json:"attachment_ids,omitempty"`
+	ReplyToMessageId   string                 `protobuf:"bytes,5,opt,name=reply_to_message_id,json=replyToMessageId,proto3" json:"reply_to_message_id,omitempty"`
+	SendAt             *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=send_at,json=sendAt,proto3" json:"send_at,omitempty"`
+	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ScheduledMessage) Reset() {
+	*x = ScheduledMessage{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduledMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledMessage) ProtoMessage() {}
+
+func (x *ScheduledMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledMessage.ProtoReflect.Descriptor instead.
+func (*ScheduledMessage) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ScheduledMessage) GetScheduledMessageId() string {
+	if x != nil {
+		return x.ScheduledMessageId
+	}
+	return ""
+}
+
+func (x *ScheduledMessage) GetChannel() *TextChannelRef {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+func (x *ScheduledMessage) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ScheduledMessage) GetAttachmentIds() []string {
+	if x != nil {
+		return x.AttachmentIds
+	}
+	return nil
+}
+
+func (x *ScheduledMessage) GetReplyToMessageId() string {
+	if x != nil {
+		return x.ReplyToMessageId
+	}
+	return ""
+}
+
+func (x *ScheduledMessage) GetSendAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SendAt
+	}
+	return nil
+}
+
+func (x *ScheduledMessage) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ScheduleMessageRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Channel          *TextChannelRef        `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Content          string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	AttachmentIds    []string               `protobuf:"bytes,3,rep,name=attachment_ids,json=attachmentIds,proto3" json:"attachment_ids,omitempty"`
+	ReplyToMessageId string                 `protobuf:"bytes,4,opt,name=reply_to_message_id,json=replyToMessageId,proto3" json:"reply_to_message_id,omitempty"`
+	SendAt           *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=send_at,json=sendAt,proto3" json:"send_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ScheduleMessageRequest) Reset() {
+	*x = ScheduleMessageRequest{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleMessageRequest) ProtoMessage() {}
+
+func (x *ScheduleMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleMessageRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleMessageRequest) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ScheduleMessageRequest) GetChannel() *TextChannelRef {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+func (x *ScheduleMessageRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ScheduleMessageRequest) GetAttachmentIds() []string {
+	if x != nil {
+		return x.AttachmentIds
+	}
+	return nil
+}
+
+func (x *ScheduleMessageRequest) GetReplyToMessageId() string {
+	if x != nil {
+		return x.ReplyToMessageId
+	}
+	return ""
+}
+
+func (x *ScheduleMessageRequest) GetSendAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SendAt
+	}
+	return nil
+}
+
+type ScheduleMessageResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ScheduledMessage *ScheduledMessage      `protobuf:"bytes,1,opt,name=scheduled_message,json=scheduledMessage,proto3" json:"scheduled_message,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ScheduleMessageResponse) Reset() {
+	*x = ScheduleMessageResponse{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleMessageResponse) ProtoMessage() {}
+
+func (x *ScheduleMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleMessageResponse.ProtoReflect.Descriptor instead.
+func (*ScheduleMessageResponse) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ScheduleMessageResponse) GetScheduledMessage() *ScheduledMessage {
+	if x != nil {
+		return x.ScheduledMessage
+	}
+	return nil
+}
+
+type ListScheduledMessagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       *TextChannelRef        `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScheduledMessagesRequest) Reset() {
+	*x = ListScheduledMessagesRequest{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScheduledMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduledMessagesRequest) ProtoMessage() {}
+
+func (x *ListScheduledMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduledMessagesRequest.ProtoReflect.Descriptor instead.
+func (*ListScheduledMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ListScheduledMessagesRequest) GetChannel() *TextChannelRef {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+type ListScheduledMessagesResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ScheduledMessages []*ScheduledMessage    `protobuf:"bytes,1,rep,name=scheduled_messages,json=scheduledMessages,proto3" json:"scheduled_messages,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ListScheduledMessagesResponse) Reset() {
+	*x = ListScheduledMessagesResponse{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScheduledMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduledMessagesResponse) ProtoMessage() {}
+
+func (x *ListScheduledMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduledMessagesResponse.ProtoReflect.Descriptor instead.
+func (*ListScheduledMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ListScheduledMessagesResponse) GetScheduledMessages() []*ScheduledMessage {
+	if x != nil {
+		return x.ScheduledMessages
+	}
+	return nil
+}
+
+type CancelScheduledMessageRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ScheduledMessageId string                 `protobuf:"bytes,1,opt,name=scheduled_message_id,json=scheduledMessageId,proto3" json:"scheduled_message_id,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CancelScheduledMessageRequest) Reset() {
+	*x = CancelScheduledMessageRequest{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelScheduledMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledMessageRequest) ProtoMessage() {}
+
+func (x *CancelScheduledMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledMessageRequest.ProtoReflect.Descriptor instead.
+func (*CancelScheduledMessageRequest) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *CancelScheduledMessageRequest) GetScheduledMessageId() string {
+	if x != nil {
+		return x.ScheduledMessageId
+	}
+	return ""
+}
+
+type CancelScheduledMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelScheduledMessageResponse) Reset() {
+	*x = CancelScheduledMessageResponse{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelScheduledMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledMessageResponse) ProtoMessage() {}
+
+func (x *CancelScheduledMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledMessageResponse.ProtoReflect.Descriptor instead.
+func (*CancelScheduledMessageResponse) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{70}
+}
+
+type DirectMessage struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Channel        *v1.TextChannel        `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	ParticipantIds []string               `protobuf:"bytes,2,rep,name=participant_ids,json=participantIds,proto3" json:"participant_ids,omitempty"`
+	LastActivityAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_activity_at,json=lastActivityAt,proto3" json:"last_activity_at,omitempty"`
+	OwnerId        string                 `protobuf:"bytes,4,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DirectMessage) Reset() {
+	*x = DirectMessage{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DirectMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DirectMessage) ProtoMessage() {}
+
+func (x *DirectMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DirectMessage.ProtoReflect.Descriptor instead.
+func (*DirectMessage) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *DirectMessage) GetChannel() *v1.TextChannel {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+func (x *DirectMessage) GetParticipantIds() []string {
+	if x != nil {
+		return x.ParticipantIds
+	}
+	return nil
+}
+
+func (x *DirectMessage) GetLastActivityAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastActivityAt
+	}
+	return nil
+}
+
+func (x *DirectMessage) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+type OpenDirectMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OpenDirectMessageRequest) Reset() {
+	*x = OpenDirectMessageRequest{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpenDirectMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenDirectMessageRequest) ProtoMessage() {}
+
+func (x *OpenDirectMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenDirectMessageRequest.ProtoReflect.Descriptor instead.
+func (*OpenDirectMessageRequest) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *OpenDirectMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type OpenDirectMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DirectMessage *DirectMessage         `protobuf:"bytes,1,opt,name=direct_message,json=directMessage,proto3" json:"direct_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OpenDirectMessageResponse) Reset() {
+	*x = OpenDirectMessageResponse{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpenDirectMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenDirectMessageResponse) ProtoMessage() {}
+
+func (x *OpenDirectMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenDirectMessageResponse.ProtoReflect.Descriptor instead.
+func (*OpenDirectMessageResponse) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *OpenDirectMessageResponse) GetDirectMessage() *DirectMessage {
+	if x != nil {
+		return x.DirectMessage
+	}
+	return nil
+}
+
+type ListDirectMessagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDirectMessagesRequest) Reset() {
+	*x = ListDirectMessagesRequest{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDirectMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDirectMessagesRequest) ProtoMessage() {}
+
+func (x *ListDirectMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDirectMessagesRequest.ProtoReflect.Descriptor instead.
+func (*ListDirectMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{74}
+}
+
+type ListDirectMessagesResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	DirectMessages []*DirectMessage       `protobuf:"bytes,1,rep,name=direct_messages,json=directMessages,proto3" json:"direct_messages,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListDirectMessagesResponse) Reset() {
+	*x = ListDirectMessagesResponse{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDirectMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDirectMessagesResponse) ProtoMessage() {}
+
+func (x *ListDirectMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDirectMessagesResponse.ProtoReflect.Descriptor instead.
+func (*ListDirectMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ListDirectMessagesResponse) GetDirectMessages() []*DirectMessage {
+	if x != nil {
+		return x.DirectMessages
+	}
+	return nil
+}
+
+type CreateGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	UserIds       []string               `protobuf:"bytes,2,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGroupRequest) Reset() {
+	*x = CreateGroupRequest{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupRequest) ProtoMessage() {}
+
+func (x *CreateGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupRequest.ProtoReflect.Descriptor instead.
+func (*CreateGroupRequest) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *CreateGroupRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateGroupRequest) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+type CreateGroupResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       *Message               `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	DirectMessage *DirectMessage         `protobuf:"bytes,1,opt,name=direct_message,json=directMessage,proto3" json:"direct_message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMessageResponse) Reset() {
-	*x = GetMessageResponse{}
-	mi := &file_confa_chat_v1_service_proto_msgTypes[8]
+func (x *CreateGroupResponse) Reset() {
+	*x = CreateGroupResponse{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMessageResponse) String() string {
+func (x *CreateGroupResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMessageResponse) ProtoMessage() {}
+func (*CreateGroupResponse) ProtoMessage() {}
 
-func (x *GetMessageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_confa_chat_v1_service_proto_msgTypes[8]
+func (x *CreateGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -502,40 +4665,41 @@ func (x *GetMessageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMessageResponse.ProtoReflect.Descriptor instead.
-func (*GetMessageResponse) Descriptor() ([]byte, []int) {
-	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use CreateGroupResponse.ProtoReflect.Descriptor instead.
+func (*CreateGroupResponse) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *GetMessageResponse) GetMessage() *Message {
+func (x *CreateGroupResponse) GetDirectMessage() *DirectMessage {
 	if x != nil {
-		return x.Message
+		return x.DirectMessage
 	}
 	return nil
 }
 
-type StreamNewMessagesRequest struct {
+type AddGroupMembersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Channel       *TextChannelRef        `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	ChannelId     string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	UserIds       []string               `protobuf:"bytes,2,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StreamNewMessagesRequest) Reset() {
-	*x = StreamNewMessagesRequest{}
-	mi := &file_confa_chat_v1_service_proto_msgTypes[9]
+func (x *AddGroupMembersRequest) Reset() {
+	*x = AddGroupMembersRequest{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StreamNewMessagesRequest) String() string {
+func (x *AddGroupMembersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamNewMessagesRequest) ProtoMessage() {}
+func (*AddGroupMembersRequest) ProtoMessage() {}
 
-func (x *StreamNewMessagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_confa_chat_v1_service_proto_msgTypes[9]
+func (x *AddGroupMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -546,40 +4710,47 @@ func (x *StreamNewMessagesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamNewMessagesRequest.ProtoReflect.Descriptor instead.
-func (*StreamNewMessagesRequest) Descriptor() ([]byte, []int) {
-	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use AddGroupMembersRequest.ProtoReflect.Descriptor instead.
+func (*AddGroupMembersRequest) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *StreamNewMessagesRequest) GetChannel() *TextChannelRef {
+func (x *AddGroupMembersRequest) GetChannelId() string {
 	if x != nil {
-		return x.Channel
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *AddGroupMembersRequest) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
 	}
 	return nil
 }
 
-type StreamNewMessagesResponse struct {
+type AddGroupMembersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	DirectMessage *DirectMessage         `protobuf:"bytes,1,opt,name=direct_message,json=directMessage,proto3" json:"direct_message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StreamNewMessagesResponse) Reset() {
-	*x = StreamNewMessagesResponse{}
-	mi := &file_confa_chat_v1_service_proto_msgTypes[10]
+func (x *AddGroupMembersResponse) Reset() {
+	*x = AddGroupMembersResponse{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StreamNewMessagesResponse) String() string {
+func (x *AddGroupMembersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamNewMessagesResponse) ProtoMessage() {}
+func (*AddGroupMembersResponse) ProtoMessage() {}
 
-func (x *StreamNewMessagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_confa_chat_v1_service_proto_msgTypes[10]
+func (x *AddGroupMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -590,44 +4761,41 @@ func (x *StreamNewMessagesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamNewMessagesResponse.ProtoReflect.Descriptor instead.
-func (*StreamNewMessagesResponse) Descriptor() ([]byte, []int) {
-	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use AddGroupMembersResponse.ProtoReflect.Descriptor instead.
+func (*AddGroupMembersResponse) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *StreamNewMessagesResponse) GetMessageId() string {
+func (x *AddGroupMembersResponse) GetDirectMessage() *DirectMessage {
 	if x != nil {
-		return x.MessageId
+		return x.DirectMessage
 	}
-	return ""
+	return nil
 }
 
-type UploadAttachmentRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Payload:
-	//
-	//	*UploadAttachmentRequest_Info
-	//	*UploadAttachmentRequest_Data
-	Payload       isUploadAttachmentRequest_Payload `protobuf_oneof:"payload"`
+type RemoveGroupMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChannelId     string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UploadAttachmentRequest) Reset() {
-	*x = UploadAttachmentRequest{}
-	mi := &file_confa_chat_v1_service_proto_msgTypes[11]
+func (x *RemoveGroupMemberRequest) Reset() {
+	*x = RemoveGroupMemberRequest{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UploadAttachmentRequest) String() string {
+func (x *RemoveGroupMemberRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadAttachmentRequest) ProtoMessage() {}
+func (*RemoveGroupMemberRequest) ProtoMessage() {}
 
-func (x *UploadAttachmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_confa_chat_v1_service_proto_msgTypes[11]
+func (x *RemoveGroupMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -638,74 +4806,135 @@ func (x *UploadAttachmentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadAttachmentRequest.ProtoReflect.Descriptor instead.
-func (*UploadAttachmentRequest) Descriptor() ([]byte, []int) {
-	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use RemoveGroupMemberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveGroupMemberRequest) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *UploadAttachmentRequest) GetPayload() isUploadAttachmentRequest_Payload {
+func (x *RemoveGroupMemberRequest) GetChannelId() string {
 	if x != nil {
-		return x.Payload
+		return x.ChannelId
 	}
-	return nil
+	return ""
 }
 
-func (x *UploadAttachmentRequest) GetInfo() *AttachmentUploadInfo {
+func (x *RemoveGroupMemberRequest) GetUserId() string {
 	if x != nil {
-		if x, ok := x.Payload.(*UploadAttachmentRequest_Info); ok {
-			return x.Info
-		}
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-func (x *UploadAttachmentRequest) GetData() []byte {
+type RemoveGroupMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveGroupMemberResponse) Reset() {
+	*x = RemoveGroupMemberResponse{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveGroupMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveGroupMemberResponse) ProtoMessage() {}
+
+func (x *RemoveGroupMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[81]
 	if x != nil {
-		if x, ok := x.Payload.(*UploadAttachmentRequest_Data); ok {
-			return x.Data
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-type isUploadAttachmentRequest_Payload interface {
-	isUploadAttachmentRequest_Payload()
+// Deprecated: Use RemoveGroupMemberResponse.ProtoReflect.Descriptor instead.
+func (*RemoveGroupMemberResponse) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{81}
 }
 
-type UploadAttachmentRequest_Info struct {
-	Info *AttachmentUploadInfo `protobuf:"bytes,1,opt,name=info,proto3,oneof"`
+type RenameGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChannelId     string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-type UploadAttachmentRequest_Data struct {
-	Data []byte `protobuf:"bytes,2,opt,name=data,proto3,oneof"`
+func (x *RenameGroupRequest) Reset() {
+	*x = RenameGroupRequest{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (*UploadAttachmentRequest_Info) isUploadAttachmentRequest_Payload() {}
+func (x *RenameGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-func (*UploadAttachmentRequest_Data) isUploadAttachmentRequest_Payload() {}
+func (*RenameGroupRequest) ProtoMessage() {}
 
-type AttachmentUploadInfo struct {
+func (x *RenameGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameGroupRequest.ProtoReflect.Descriptor instead.
+func (*RenameGroupRequest) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *RenameGroupRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *RenameGroupRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RenameGroupResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DirectMessage *DirectMessage         `protobuf:"bytes,1,opt,name=direct_message,json=directMessage,proto3" json:"direct_message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AttachmentUploadInfo) Reset() {
-	*x = AttachmentUploadInfo{}
-	mi := &file_confa_chat_v1_service_proto_msgTypes[12]
+func (x *RenameGroupResponse) Reset() {
+	*x = RenameGroupResponse{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AttachmentUploadInfo) String() string {
+func (x *RenameGroupResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AttachmentUploadInfo) ProtoMessage() {}
+func (*RenameGroupResponse) ProtoMessage() {}
 
-func (x *AttachmentUploadInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_confa_chat_v1_service_proto_msgTypes[12]
+func (x *RenameGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -716,40 +4945,40 @@ func (x *AttachmentUploadInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AttachmentUploadInfo.ProtoReflect.Descriptor instead.
-func (*AttachmentUploadInfo) Descriptor() ([]byte, []int) {
-	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use RenameGroupResponse.ProtoReflect.Descriptor instead.
+func (*RenameGroupResponse) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *AttachmentUploadInfo) GetName() string {
+func (x *RenameGroupResponse) GetDirectMessage() *DirectMessage {
 	if x != nil {
-		return x.Name
+		return x.DirectMessage
 	}
-	return ""
+	return nil
 }
 
-type UploadAttachmentResponse struct {
+type LeaveGroupRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AttachmentId  string                 `protobuf:"bytes,1,opt,name=attachment_id,json=attachmentId,proto3" json:"attachment_id,omitempty"`
+	ChannelId     string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UploadAttachmentResponse) Reset() {
-	*x = UploadAttachmentResponse{}
-	mi := &file_confa_chat_v1_service_proto_msgTypes[13]
+func (x *LeaveGroupRequest) Reset() {
+	*x = LeaveGroupRequest{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UploadAttachmentResponse) String() string {
+func (x *LeaveGroupRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadAttachmentResponse) ProtoMessage() {}
+func (*LeaveGroupRequest) ProtoMessage() {}
 
-func (x *UploadAttachmentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_confa_chat_v1_service_proto_msgTypes[13]
+func (x *LeaveGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -760,63 +4989,167 @@ func (x *UploadAttachmentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadAttachmentResponse.ProtoReflect.Descriptor instead.
-func (*UploadAttachmentResponse) Descriptor() ([]byte, []int) {
-	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use LeaveGroupRequest.ProtoReflect.Descriptor instead.
+func (*LeaveGroupRequest) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *UploadAttachmentResponse) GetAttachmentId() string {
+func (x *LeaveGroupRequest) GetChannelId() string {
 	if x != nil {
-		return x.AttachmentId
+		return x.ChannelId
 	}
 	return ""
 }
 
+type LeaveGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveGroupResponse) Reset() {
+	*x = LeaveGroupResponse{}
+	mi := &file_confa_chat_v1_service_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveGroupResponse) ProtoMessage() {}
+
+func (x *LeaveGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_chat_v1_service_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveGroupResponse.ProtoReflect.Descriptor instead.
+func (*LeaveGroupResponse) Descriptor() ([]byte, []int) {
+	return file_confa_chat_v1_service_proto_rawDescGZIP(), []int{85}
+}
+
 var File_confa_chat_v1_service_proto protoreflect.FileDescriptor
 
 const file_confa_chat_v1_service_proto_rawDesc = "" +
 	"\n" +
-	"\x1bconfa/chat/v1/service.proto\x12\rconfa.chat.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"L\n" +
+	"\x1bconfa/chat/v1/service.proto\x12\rconfa.chat.v1\x1a\x1fconfa/channel/v1/channels.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"L\n" +
 	"\x0eTextChannelRef\x12\x1b\n" +
 	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12\x1d\n" +
 	"\n" +
-	"channel_id\x18\x02 \x01(\tR\tchannelId\"\x8e\x01\n" +
+	"channel_id\x18\x02 \x01(\tR\tchannelId\"\xea\x01\n" +
 	"\x12SendMessageRequest\x127\n" +
 	"\achannel\x18\x01 \x01(\v2\x1d.confa.chat.v1.TextChannelRefR\achannel\x12\x18\n" +
 	"\acontent\x18\x02 \x01(\tR\acontent\x12%\n" +
-	"\x0eattachment_ids\x18\x03 \x03(\tR\rattachmentIds\"4\n" +
+	"\x0eattachment_ids\x18\x03 \x03(\tR\rattachmentIds\x12-\n" +
+	"\x13reply_to_message_id\x18\x04 \x01(\tR\x10replyToMessageId\x12+\n" +
+	"\x03ttl\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\x03ttl\"4\n" +
 	"\x13SendMessageResponse\x12\x1d\n" +
 	"\n" +
-	"message_id\x18\x01 \x01(\tR\tmessageId\"\xd6\x01\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId\"\xc1\x05\n" +
 	"\aMessage\x12\x1d\n" +
 	"\n" +
 	"message_id\x18\x01 \x01(\tR\tmessageId\x12\x1b\n" +
 	"\tsender_id\x18\x04 \x01(\tR\bsenderId\x12\x18\n" +
 	"\acontent\x18\x05 \x01(\tR\acontent\x128\n" +
 	"\ttimestamp\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12;\n" +
-	"\vattachments\x18\a \x03(\v2\x19.confa.chat.v1.AttachmentR\vattachments\"W\n" +
+	"\vattachments\x18\a \x03(\v2\x19.confa.chat.v1.AttachmentR\vattachments\x127\n" +
+	"\tedited_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\beditedAt\x129\n" +
+	"\n" +
+	"deleted_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12*\n" +
+	"\x11parent_message_id\x18\n" +
+	" \x01(\tR\x0fparentMessageId\x12\x1f\n" +
+	"\vreply_count\x18\v \x01(\x05R\n" +
+	"replyCount\x125\n" +
+	"\treactions\x18\f \x03(\v2\x17.confa.chat.v1.ReactionR\treactions\x12?\n" +
+	"\rcontent_nodes\x18\r \x03(\v2\x1a.confa.chat.v1.ContentNodeR\fcontentNodes\x126\n" +
+	"\bpreviews\x18\x0e \x03(\v2\x1a.confa.chat.v1.LinkPreviewR\bpreviews\x129\n" +
+	"\n" +
+	"expires_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12=\n" +
+	"\fsystem_event\x18\x10 \x01(\v2\x1a.confa.chat.v1.SystemEventR\vsystemEvent\"p\n" +
+	"\vSystemEvent\x122\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1e.confa.chat.v1.SystemEventTypeR\x04type\x12\x19\n" +
+	"\buser_ids\x18\x02 \x03(\tR\auserIds\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"\x99\x01\n" +
+	"\vLinkPreview\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1b\n" +
+	"\tsite_name\x18\x04 \x01(\tR\bsiteName\x12#\n" +
+	"\rthumbnail_url\x18\x05 \x01(\tR\fthumbnailUrl\"\xbb\x01\n" +
+	"\vContentNode\x122\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1e.confa.chat.v1.ContentNodeTypeR\x04type\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12\x1a\n" +
+	"\blanguage\x18\x04 \x01(\tR\blanguage\x126\n" +
+	"\bchildren\x18\x05 \x03(\v2\x1a.confa.chat.v1.ContentNodeR\bchildren\"Z\n" +
+	"\bReaction\x12\x14\n" +
+	"\x05emoji\x18\x01 \x01(\tR\x05emoji\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\x12\"\n" +
+	"\rreacted_by_me\x18\x03 \x01(\bR\vreactedByMe\"W\n" +
 	"\n" +
 	"Attachment\x12#\n" +
 	"\rattachment_id\x18\x01 \x01(\tR\fattachmentId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x10\n" +
-	"\x03url\x18\x03 \x01(\tR\x03url\"\x99\x01\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\"\xfe\x01\n" +
 	"\x18GetMessageHistoryRequest\x127\n" +
 	"\achannel\x18\x01 \x01(\v2\x1d.confa.chat.v1.TextChannelRefR\achannel\x12.\n" +
 	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12\x14\n" +
-	"\x05count\x18\x03 \x01(\x05R\x05count\"O\n" +
+	"\x05count\x18\x03 \x01(\x05R\x05count\x12\x1d\n" +
+	"\tbefore_id\x18\x04 \x01(\tH\x00R\bbeforeId\x12\x1b\n" +
+	"\bafter_id\x18\x05 \x01(\tH\x00R\aafterId\x12\x1d\n" +
+	"\taround_id\x18\x06 \x01(\tH\x00R\baroundIdB\b\n" +
+	"\x06anchor\"\x9d\x01\n" +
 	"\x19GetMessageHistoryResponse\x122\n" +
-	"\bmessages\x18\x01 \x03(\v2\x16.confa.chat.v1.MessageR\bmessages\"k\n" +
+	"\bmessages\x18\x01 \x03(\v2\x16.confa.chat.v1.MessageR\bmessages\x12&\n" +
+	"\x0fhas_more_before\x18\x02 \x01(\bR\rhasMoreBefore\x12$\n" +
+	"\x0ehas_more_after\x18\x03 \x01(\bR\fhasMoreAfter\"k\n" +
 	"\x11GetMessageRequest\x127\n" +
 	"\achannel\x18\x01 \x01(\v2\x1d.confa.chat.v1.TextChannelRefR\achannel\x12\x1d\n" +
 	"\n" +
 	"message_id\x18\x02 \x01(\tR\tmessageId\"F\n" +
 	"\x12GetMessageResponse\x120\n" +
-	"\amessage\x18\x01 \x01(\v2\x16.confa.chat.v1.MessageR\amessage\"S\n" +
+	"\amessage\x18\x01 \x01(\v2\x16.confa.chat.v1.MessageR\amessage\"{\n" +
 	"\x18StreamNewMessagesRequest\x127\n" +
-	"\achannel\x18\x01 \x01(\v2\x1d.confa.chat.v1.TextChannelRefR\achannel\":\n" +
-	"\x19StreamNewMessagesResponse\x12\x1d\n" +
+	"\achannel\x18\x01 \x01(\v2\x1d.confa.chat.v1.TextChannelRefR\achannel\x12&\n" +
+	"\x0flast_message_id\x18\x02 \x01(\tR\rlastMessageId\"\xaa\x01\n" +
+	"\x19StreamNewMessagesResponse\x12!\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\tB\x02\x18\x01R\tmessageId\x127\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x1f.confa.chat.v1.MessageEventTypeB\x02\x18\x01R\x04type\x121\n" +
+	"\x05event\x18\x03 \x01(\v2\x1b.confa.chat.v1.MessageEventR\x05event\"\xd4\x03\n" +
+	"\fMessageEvent\x12>\n" +
+	"\acreated\x18\x01 \x01(\v2\".confa.chat.v1.MessageCreatedEventH\x00R\acreated\x12;\n" +
+	"\x06edited\x18\x02 \x01(\v2!.confa.chat.v1.MessageEditedEventH\x00R\x06edited\x12>\n" +
+	"\adeleted\x18\x03 \x01(\v2\".confa.chat.v1.MessageDeletedEventH\x00R\adeleted\x12Q\n" +
+	"\x0ethread_updated\x18\x04 \x01(\v2(.confa.chat.v1.MessageThreadUpdatedEventH\x00R\rthreadUpdated\x12A\n" +
+	"\breaction\x18\x05 \x01(\v2#.confa.chat.v1.MessageReactionEventH\x00R\breaction\x124\n" +
+	"\x06typing\x18\x06 \x01(\v2\x1a.confa.chat.v1.TypingEventH\x00R\x06typing\x122\n" +
+	"\x03pin\x18\a \x01(\v2\x1e.confa.chat.v1.MessagePinEventH\x00R\x03pinB\a\n" +
+	"\x05event\"G\n" +
+	"\x13MessageCreatedEvent\x120\n" +
+	"\amessage\x18\x01 \x01(\v2\x16.confa.chat.v1.MessageR\amessage\"F\n" +
+	"\x12MessageEditedEvent\x120\n" +
+	"\amessage\x18\x01 \x01(\v2\x16.confa.chat.v1.MessageR\amessage\"G\n" +
+	"\x13MessageDeletedEvent\x120\n" +
+	"\amessage\x18\x01 \x01(\v2\x16.confa.chat.v1.MessageR\amessage\"M\n" +
+	"\x19MessageThreadUpdatedEvent\x120\n" +
+	"\amessage\x18\x01 \x01(\v2\x16.confa.chat.v1.MessageR\amessage\"\x90\x01\n" +
+	"\x14MessageReactionEvent\x12\x1d\n" +
 	"\n" +
-	"message_id\x18\x01 \x01(\tR\tmessageId\"u\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05emoji\x18\x03 \x01(\tR\x05emoji\x12\x14\n" +
+	"\x05added\x18\x04 \x01(\bR\x05added\x12\x14\n" +
+	"\x05count\x18\x05 \x01(\x05R\x05count\"u\n" +
 	"\x17UploadAttachmentRequest\x129\n" +
 	"\x04info\x18\x01 \x01(\v2#.confa.chat.v1.AttachmentUploadInfoH\x00R\x04info\x12\x14\n" +
 	"\x04data\x18\x02 \x01(\fH\x00R\x04dataB\t\n" +
@@ -824,14 +5157,269 @@ const file_confa_chat_v1_service_proto_rawDesc = "" +
 	"\x14AttachmentUploadInfo\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\"?\n" +
 	"\x18UploadAttachmentResponse\x12#\n" +
-	"\rattachment_id\x18\x01 \x01(\tR\fattachmentId2\xf9\x03\n" +
+	"\rattachment_id\x18\x01 \x01(\tR\fattachmentId\"\x86\x01\n" +
+	"\x12EditMessageRequest\x127\n" +
+	"\achannel\x18\x01 \x01(\v2\x1d.confa.chat.v1.TextChannelRefR\achannel\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x02 \x01(\tR\tmessageId\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\"G\n" +
+	"\x13EditMessageResponse\x120\n" +
+	"\amessage\x18\x01 \x01(\v2\x16.confa.chat.v1.MessageR\amessage\"\xa5\x01\n" +
+	"\x0fMessageRevision\x12\x1f\n" +
+	"\vrevision_id\x18\x01 \x01(\tR\n" +
+	"revisionId\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x02 \x01(\tR\tmessageId\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x128\n" +
+	"\ttimestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"u\n" +
+	"\x1bListMessageRevisionsRequest\x127\n" +
+	"\achannel\x18\x01 \x01(\v2\x1d.confa.chat.v1.TextChannelRefR\achannel\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x02 \x01(\tR\tmessageId\"\\\n" +
+	"\x1cListMessageRevisionsResponse\x12<\n" +
+	"\trevisions\x18\x01 \x03(\v2\x1e.confa.chat.v1.MessageRevisionR\trevisions\"n\n" +
+	"\x14DeleteMessageRequest\x127\n" +
+	"\achannel\x18\x01 \x01(\v2\x1d.confa.chat.v1.TextChannelRefR\achannel\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x02 \x01(\tR\tmessageId\"\x17\n" +
+	"\x15DeleteMessageResponse\"\xc0\x01\n" +
+	"\x19ListThreadMessagesRequest\x127\n" +
+	"\achannel\x18\x01 \x01(\v2\x1d.confa.chat.v1.TextChannelRefR\achannel\x12*\n" +
+	"\x11parent_message_id\x18\x02 \x01(\tR\x0fparentMessageId\x12(\n" +
+	"\x10after_message_id\x18\x03 \x01(\tR\x0eafterMessageId\x12\x14\n" +
+	"\x05count\x18\x04 \x01(\x05R\x05count\"k\n" +
+	"\x1aListThreadMessagesResponse\x122\n" +
+	"\bmessages\x18\x01 \x03(\v2\x16.confa.chat.v1.MessageR\bmessages\x12\x19\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore\"\xaa\x01\n" +
+	"\x1bStreamThreadMessagesRequest\x127\n" +
+	"\achannel\x18\x01 \x01(\v2\x1d.confa.chat.v1.TextChannelRefR\achannel\x12*\n" +
+	"\x11parent_message_id\x18\x02 \x01(\tR\x0fparentMessageId\x12&\n" +
+	"\x0flast_message_id\x18\x03 \x01(\tR\rlastMessageId\"\x82\x01\n" +
+	"\x12AddReactionRequest\x127\n" +
+	"\achannel\x18\x01 \x01(\v2\x1d.confa.chat.v1.TextChannelRefR\achannel\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x02 \x01(\tR\tmessageId\x12\x14\n" +
+	"\x05emoji\x18\x03 \x01(\tR\x05emoji\"\x15\n" +
+	"\x13AddReactionResponse\"\x85\x01\n" +
+	"\x15RemoveReactionRequest\x127\n" +
+	"\achannel\x18\x01 \x01(\v2\x1d.confa.chat.v1.TextChannelRefR\achannel\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x02 \x01(\tR\tmessageId\x12\x14\n" +
+	"\x05emoji\x18\x03 \x01(\tR\x05emoji\"\x18\n" +
+	"\x16RemoveReactionResponse\"\xcf\x02\n" +
+	"\x15SearchMessagesRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x1b\n" +
+	"\tserver_id\x18\x02 \x01(\tR\bserverId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x03 \x01(\tR\tchannelId\x12\x1b\n" +
+	"\tauthor_id\x18\x04 \x01(\tR\bauthorId\x12.\n" +
+	"\x04from\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12*\n" +
+	"\x0ehas_attachment\x18\a \x01(\bH\x00R\rhasAttachment\x88\x01\x01\x12\x14\n" +
+	"\x05count\x18\b \x01(\x05R\x05count\x12\x16\n" +
+	"\x06offset\x18\t \x01(\x05R\x06offsetB\x11\n" +
+	"\x0f_has_attachment\"j\n" +
+	"\x16SearchMessagesResponse\x125\n" +
+	"\aresults\x18\x01 \x03(\v2\x1b.confa.chat.v1.SearchResultR\aresults\x12\x19\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore\"\xa7\x01\n" +
+	"\fSearchResult\x127\n" +
+	"\achannel\x18\x01 \x01(\v2\x1d.confa.chat.v1.TextChannelRefR\achannel\x120\n" +
+	"\amessage\x18\x02 \x01(\v2\x16.confa.chat.v1.MessageR\amessage\x12\x18\n" +
+	"\asnippet\x18\x03 \x01(\tR\asnippet\x12\x12\n" +
+	"\x04rank\x18\x04 \x01(\x02R\x04rank\"\xa1\x02\n" +
+	"\aMention\x12\x1d\n" +
+	"\n" +
+	"mention_id\x18\x01 \x01(\tR\tmentionId\x127\n" +
+	"\achannel\x18\x02 \x01(\v2\x1d.confa.chat.v1.TextChannelRefR\achannel\x120\n" +
+	"\amessage\x18\x03 \x01(\v2\x16.confa.chat.v1.MessageR\amessage\x12.\n" +
+	"\x04kind\x18\x04 \x01(\x0e2\x1a.confa.chat.v1.MentionKindR\x04kind\x128\n" +
+	"\ttimestamp\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\"\n" +
+	"\facknowledged\x18\x06 \x01(\bR\facknowledged\"\x88\x01\n" +
+	"\x13ListMentionsRequest\x12/\n" +
+	"\x13unacknowledged_only\x18\x01 \x01(\bR\x12unacknowledgedOnly\x12*\n" +
+	"\x11before_mention_id\x18\x02 \x01(\tR\x0fbeforeMentionId\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x05R\x05count\"e\n" +
+	"\x14ListMentionsResponse\x122\n" +
+	"\bmentions\x18\x01 \x03(\v2\x16.confa.chat.v1.MentionR\bmentions\x12\x19\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore\"O\n" +
+	"\x1aAcknowledgeMentionsRequest\x12\x1f\n" +
+	"\vmention_ids\x18\x01 \x03(\tR\n" +
+	"mentionIds\x12\x10\n" +
+	"\x03all\x18\x02 \x01(\bR\x03all\"\x1d\n" +
+	"\x1bAcknowledgeMentionsResponse\"\x17\n" +
+	"\x15StreamMentionsRequest\"J\n" +
+	"\x16StreamMentionsResponse\x120\n" +
+	"\amention\x18\x01 \x01(\v2\x16.confa.chat.v1.MentionR\amention\"\xb0\x01\n" +
+	"\tReadState\x127\n" +
+	"\achannel\x18\x01 \x01(\v2\x1d.confa.chat.v1.TextChannelRefR\achannel\x12/\n" +
+	"\x14last_read_message_id\x18\x02 \x01(\tR\x11lastReadMessageId\x129\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"p\n" +
+	"\x16MarkChannelReadRequest\x127\n" +
+	"\achannel\x18\x01 \x01(\v2\x1d.confa.chat.v1.TextChannelRefR\achannel\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x02 \x01(\tR\tmessageId\"R\n" +
+	"\x17MarkChannelReadResponse\x127\n" +
+	"\n" +
+	"read_state\x18\x01 \x01(\v2\x18.confa.chat.v1.ReadStateR\treadState\"\x18\n" +
+	"\x16StreamReadStateRequest\"R\n" +
+	"\x17StreamReadStateResponse\x127\n" +
+	"\n" +
+	"read_state\x18\x01 \x01(\v2\x18.confa.chat.v1.ReadStateR\treadState\"y\n" +
+	"\vTypingEvent\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06typing\x18\x02 \x01(\bR\x06typing\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"c\n" +
+	"\x10SetTypingRequest\x127\n" +
+	"\achannel\x18\x01 \x01(\v2\x1d.confa.chat.v1.TextChannelRefR\achannel\x12\x16\n" +
+	"\x06typing\x18\x02 \x01(\bR\x06typing\"\x13\n" +
+	"\x11SetTypingResponse\"x\n" +
+	"\x0fMessagePinEvent\x120\n" +
+	"\amessage\x18\x01 \x01(\v2\x16.confa.chat.v1.MessageR\amessage\x12\x16\n" +
+	"\x06pinned\x18\x02 \x01(\bR\x06pinned\x12\x1b\n" +
+	"\tpinned_by\x18\x03 \x01(\tR\bpinnedBy\"\x97\x01\n" +
+	"\rPinnedMessage\x120\n" +
+	"\amessage\x18\x01 \x01(\v2\x16.confa.chat.v1.MessageR\amessage\x12\x1b\n" +
+	"\tpinned_by\x18\x02 \x01(\tR\bpinnedBy\x127\n" +
+	"\tpinned_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bpinnedAt\"k\n" +
+	"\x11PinMessageRequest\x127\n" +
+	"\achannel\x18\x01 \x01(\v2\x1d.confa.chat.v1.TextChannelRefR\achannel\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x02 \x01(\tR\tmessageId\"\x14\n" +
+	"\x12PinMessageResponse\"m\n" +
+	"\x13UnpinMessageRequest\x127\n" +
+	"\achannel\x18\x01 \x01(\v2\x1d.confa.chat.v1.TextChannelRefR\achannel\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x02 \x01(\tR\tmessageId\"\x16\n" +
+	"\x14UnpinMessageResponse\"T\n" +
+	"\x19ListPinnedMessagesRequest\x127\n" +
+	"\achannel\x18\x01 \x01(\v2\x1d.confa.chat.v1.TextChannelRefR\achannel\"N\n" +
+	"\x1aListPinnedMessagesResponse\x120\n" +
+	"\x04pins\x18\x01 \x03(\v2\x1c.confa.chat.v1.PinnedMessageR\x04pins\"\xdd\x02\n" +
+	"\x10ScheduledMessage\x120\n" +
+	"\x14scheduled_message_id\x18\x01 \x01(\tR\x12scheduledMessageId\x127\n" +
+	"\achannel\x18\x02 \x01(\v2\x1d.confa.chat.v1.TextChannelRefR\achannel\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x12%\n" +
+	"\x0eattachment_ids\x18\x04 \x03(\tR\rattachmentIds\x12-\n" +
+	"\x13reply_to_message_id\x18\x05 \x01(\tR\x10replyToMessageId\x123\n" +
+	"\asend_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x06sendAt\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xf6\x01\n" +
+	"\x16ScheduleMessageRequest\x127\n" +
+	"\achannel\x18\x01 \x01(\v2\x1d.confa.chat.v1.TextChannelRefR\achannel\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x12%\n" +
+	"\x0eattachment_ids\x18\x03 \x03(\tR\rattachmentIds\x12-\n" +
+	"\x13reply_to_message_id\x18\x04 \x01(\tR\x10replyToMessageId\x123\n" +
+	"\asend_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x06sendAt\"g\n" +
+	"\x17ScheduleMessageResponse\x12L\n" +
+	"\x11scheduled_message\x18\x01 \x01(\v2\x1f.confa.chat.v1.ScheduledMessageR\x10scheduledMessage\"W\n" +
+	"\x1cListScheduledMessagesRequest\x127\n" +
+	"\achannel\x18\x01 \x01(\v2\x1d.confa.chat.v1.TextChannelRefR\achannel\"o\n" +
+	"\x1dListScheduledMessagesResponse\x12N\n" +
+	"\x12scheduled_messages\x18\x01 \x03(\v2\x1f.confa.chat.v1.ScheduledMessageR\x11scheduledMessages\"Q\n" +
+	"\x1dCancelScheduledMessageRequest\x120\n" +
+	"\x14scheduled_message_id\x18\x01 \x01(\tR\x12scheduledMessageId\" \n" +
+	"\x1eCancelScheduledMessageResponse\"\xd2\x01\n" +
+	"\rDirectMessage\x127\n" +
+	"\achannel\x18\x01 \x01(\v2\x1d.confa.channel.v1.TextChannelR\achannel\x12'\n" +
+	"\x0fparticipant_ids\x18\x02 \x03(\tR\x0eparticipantIds\x12D\n" +
+	"\x10last_activity_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x0elastActivityAt\x12\x19\n" +
+	"\bowner_id\x18\x04 \x01(\tR\aownerId\"3\n" +
+	"\x18OpenDirectMessageRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"`\n" +
+	"\x19OpenDirectMessageResponse\x12C\n" +
+	"\x0edirect_message\x18\x01 \x01(\v2\x1c.confa.chat.v1.DirectMessageR\rdirectMessage\"\x1b\n" +
+	"\x19ListDirectMessagesRequest\"c\n" +
+	"\x1aListDirectMessagesResponse\x12E\n" +
+	"\x0fdirect_messages\x18\x01 \x03(\v2\x1c.confa.chat.v1.DirectMessageR\x0edirectMessages\"C\n" +
+	"\x12CreateGroupRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x19\n" +
+	"\buser_ids\x18\x02 \x03(\tR\auserIds\"Z\n" +
+	"\x13CreateGroupResponse\x12C\n" +
+	"\x0edirect_message\x18\x01 \x01(\v2\x1c.confa.chat.v1.DirectMessageR\rdirectMessage\"R\n" +
+	"\x16AddGroupMembersRequest\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x01 \x01(\tR\tchannelId\x12\x19\n" +
+	"\buser_ids\x18\x02 \x03(\tR\auserIds\"^\n" +
+	"\x17AddGroupMembersResponse\x12C\n" +
+	"\x0edirect_message\x18\x01 \x01(\v2\x1c.confa.chat.v1.DirectMessageR\rdirectMessage\"R\n" +
+	"\x18RemoveGroupMemberRequest\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x01 \x01(\tR\tchannelId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x1b\n" +
+	"\x19RemoveGroupMemberResponse\"G\n" +
+	"\x12RenameGroupRequest\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x01 \x01(\tR\tchannelId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"Z\n" +
+	"\x13RenameGroupResponse\x12C\n" +
+	"\x0edirect_message\x18\x01 \x01(\v2\x1c.confa.chat.v1.DirectMessageR\rdirectMessage\"2\n" +
+	"\x11LeaveGroupRequest\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x01 \x01(\tR\tchannelId\"\x14\n" +
+	"\x12LeaveGroupResponse*\xec\x01\n" +
+	"\x0fSystemEventType\x12!\n" +
+	"\x1dSYSTEM_EVENT_TYPE_UNSPECIFIED\x10\x00\x12#\n" +
+	"\x1fSYSTEM_EVENT_TYPE_GROUP_CREATED\x10\x01\x12#\n" +
+	"\x1fSYSTEM_EVENT_TYPE_MEMBERS_ADDED\x10\x02\x12$\n" +
+	" SYSTEM_EVENT_TYPE_MEMBER_REMOVED\x10\x03\x12!\n" +
+	"\x1dSYSTEM_EVENT_TYPE_MEMBER_LEFT\x10\x04\x12#\n" +
+	"\x1fSYSTEM_EVENT_TYPE_GROUP_RENAMED\x10\x05*\xa2\x02\n" +
+	"\x0fContentNodeType\x12!\n" +
+	"\x1dCONTENT_NODE_TYPE_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16CONTENT_NODE_TYPE_TEXT\x10\x01\x12\x1a\n" +
+	"\x16CONTENT_NODE_TYPE_BOLD\x10\x02\x12\x1c\n" +
+	"\x18CONTENT_NODE_TYPE_ITALIC\x10\x03\x12\x1a\n" +
+	"\x16CONTENT_NODE_TYPE_CODE\x10\x04\x12 \n" +
+	"\x1cCONTENT_NODE_TYPE_CODE_BLOCK\x10\x05\x12\x1a\n" +
+	"\x16CONTENT_NODE_TYPE_LINK\x10\x06\x12\x1d\n" +
+	"\x19CONTENT_NODE_TYPE_MENTION\x10\a\x12\x1d\n" +
+	"\x19CONTENT_NODE_TYPE_SPOILER\x10\b*q\n" +
+	"\x10MessageEventType\x12\x1e\n" +
+	"\x1aMESSAGE_EVENT_TYPE_CREATED\x10\x00\x12\x1d\n" +
+	"\x19MESSAGE_EVENT_TYPE_EDITED\x10\x01\x12\x1e\n" +
+	"\x1aMESSAGE_EVENT_TYPE_DELETED\x10\x02*Y\n" +
+	"\vMentionKind\x12\x15\n" +
+	"\x11MENTION_KIND_USER\x10\x00\x12\x19\n" +
+	"\x15MENTION_KIND_EVERYONE\x10\x01\x12\x18\n" +
+	"\x14MENTION_KIND_CHANNEL\x10\x022\xff\x18\n" +
 	"\vChatService\x12V\n" +
 	"\vSendMessage\x12!.confa.chat.v1.SendMessageRequest\x1a\".confa.chat.v1.SendMessageResponse\"\x00\x12h\n" +
 	"\x11GetMessageHistory\x12'.confa.chat.v1.GetMessageHistoryRequest\x1a(.confa.chat.v1.GetMessageHistoryResponse\"\x00\x12S\n" +
 	"\n" +
 	"GetMessage\x12 .confa.chat.v1.GetMessageRequest\x1a!.confa.chat.v1.GetMessageResponse\"\x00\x12j\n" +
 	"\x11StreamNewMessages\x12'.confa.chat.v1.StreamNewMessagesRequest\x1a(.confa.chat.v1.StreamNewMessagesResponse\"\x000\x01\x12g\n" +
-	"\x10UploadAttachment\x12&.confa.chat.v1.UploadAttachmentRequest\x1a'.confa.chat.v1.UploadAttachmentResponse\"\x00(\x01B\xb2\x01\n" +
+	"\x10UploadAttachment\x12&.confa.chat.v1.UploadAttachmentRequest\x1a'.confa.chat.v1.UploadAttachmentResponse\"\x00(\x01\x12V\n" +
+	"\vEditMessage\x12!.confa.chat.v1.EditMessageRequest\x1a\".confa.chat.v1.EditMessageResponse\"\x00\x12q\n" +
+	"\x14ListMessageRevisions\x12*.confa.chat.v1.ListMessageRevisionsRequest\x1a+.confa.chat.v1.ListMessageRevisionsResponse\"\x00\x12\\\n" +
+	"\rDeleteMessage\x12#.confa.chat.v1.DeleteMessageRequest\x1a$.confa.chat.v1.DeleteMessageResponse\"\x00\x12k\n" +
+	"\x12ListThreadMessages\x12(.confa.chat.v1.ListThreadMessagesRequest\x1a).confa.chat.v1.ListThreadMessagesResponse\"\x00\x12p\n" +
+	"\x14StreamThreadMessages\x12*.confa.chat.v1.StreamThreadMessagesRequest\x1a(.confa.chat.v1.StreamNewMessagesResponse\"\x000\x01\x12V\n" +
+	"\vAddReaction\x12!.confa.chat.v1.AddReactionRequest\x1a\".confa.chat.v1.AddReactionResponse\"\x00\x12_\n" +
+	"\x0eRemoveReaction\x12$.confa.chat.v1.RemoveReactionRequest\x1a%.confa.chat.v1.RemoveReactionResponse\"\x00\x12_\n" +
+	"\x0eSearchMessages\x12$.confa.chat.v1.SearchMessagesRequest\x1a%.confa.chat.v1.SearchMessagesResponse\"\x00\x12Y\n" +
+	"\fListMentions\x12\".confa.chat.v1.ListMentionsRequest\x1a#.confa.chat.v1.ListMentionsResponse\"\x00\x12n\n" +
+	"\x13AcknowledgeMentions\x12).confa.chat.v1.AcknowledgeMentionsRequest\x1a*.confa.chat.v1.AcknowledgeMentionsResponse\"\x00\x12a\n" +
+	"\x0eStreamMentions\x12$.confa.chat.v1.StreamMentionsRequest\x1a%.confa.chat.v1.StreamMentionsResponse\"\x000\x01\x12b\n" +
+	"\x0fMarkChannelRead\x12%.confa.chat.v1.MarkChannelReadRequest\x1a&.confa.chat.v1.MarkChannelReadResponse\"\x00\x12d\n" +
+	"\x0fStreamReadState\x12%.confa.chat.v1.StreamReadStateRequest\x1a&.confa.chat.v1.StreamReadStateResponse\"\x000\x01\x12P\n" +
+	"\tSetTyping\x12\x1f.confa.chat.v1.SetTypingRequest\x1a .confa.chat.v1.SetTypingResponse\"\x00\x12S\n" +
+	"\n" +
+	"PinMessage\x12 .confa.chat.v1.PinMessageRequest\x1a!.confa.chat.v1.PinMessageResponse\"\x00\x12Y\n" +
+	"\fUnpinMessage\x12\".confa.chat.v1.UnpinMessageRequest\x1a#.confa.chat.v1.UnpinMessageResponse\"\x00\x12k\n" +
+	"\x12ListPinnedMessages\x12(.confa.chat.v1.ListPinnedMessagesRequest\x1a).confa.chat.v1.ListPinnedMessagesResponse\"\x00\x12b\n" +
+	"\x0fScheduleMessage\x12%.confa.chat.v1.ScheduleMessageRequest\x1a&.confa.chat.v1.ScheduleMessageResponse\"\x00\x12t\n" +
+	"\x15ListScheduledMessages\x12+.confa.chat.v1.ListScheduledMessagesRequest\x1a,.confa.chat.v1.ListScheduledMessagesResponse\"\x00\x12w\n" +
+	"\x16CancelScheduledMessage\x12,.confa.chat.v1.CancelScheduledMessageRequest\x1a-.confa.chat.v1.CancelScheduledMessageResponse\"\x00\x12h\n" +
+	"\x11OpenDirectMessage\x12'.confa.chat.v1.OpenDirectMessageRequest\x1a(.confa.chat.v1.OpenDirectMessageResponse\"\x00\x12k\n" +
+	"\x12ListDirectMessages\x12(.confa.chat.v1.ListDirectMessagesRequest\x1a).confa.chat.v1.ListDirectMessagesResponse\"\x00\x12V\n" +
+	"\vCreateGroup\x12!.confa.chat.v1.CreateGroupRequest\x1a\".confa.chat.v1.CreateGroupResponse\"\x00\x12b\n" +
+	"\x0fAddGroupMembers\x12%.confa.chat.v1.AddGroupMembersRequest\x1a&.confa.chat.v1.AddGroupMembersResponse\"\x00\x12h\n" +
+	"\x11RemoveGroupMember\x12'.confa.chat.v1.RemoveGroupMemberRequest\x1a(.confa.chat.v1.RemoveGroupMemberResponse\"\x00\x12V\n" +
+	"\vRenameGroup\x12!.confa.chat.v1.RenameGroupRequest\x1a\".confa.chat.v1.RenameGroupResponse\"\x00\x12S\n" +
+	"\n" +
+	"LeaveGroup\x12 .confa.chat.v1.LeaveGroupRequest\x1a!.confa.chat.v1.LeaveGroupResponse\"\x00B\xb2\x01\n" +
 	"\x11com.confa.chat.v1B\fServiceProtoP\x01Z9github.com/confa-chat/node/src/proto/confa/chat/v1;chatv1\xa2\x02\x03CCX\xaa\x02\rConfa.Chat.V1\xca\x02\rConfa\\Chat\\V1\xe2\x02\x19Confa\\Chat\\V1\\GPBMetadata\xea\x02\x0fConfa::Chat::V1b\x06proto3"
 
 var (
@@ -846,50 +5434,258 @@ func file_confa_chat_v1_service_proto_rawDescGZIP() []byte {
 	return file_confa_chat_v1_service_proto_rawDescData
 }
 
-var file_confa_chat_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_confa_chat_v1_service_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_confa_chat_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 86)
 var file_confa_chat_v1_service_proto_goTypes = []any{
-	(*TextChannelRef)(nil),            // 0: confa.chat.v1.TextChannelRef
-	(*SendMessageRequest)(nil),        // 1: confa.chat.v1.SendMessageRequest
-	(*SendMessageResponse)(nil),       // 2: confa.chat.v1.SendMessageResponse
-	(*Message)(nil),                   // 3: confa.chat.v1.Message
-	(*Attachment)(nil),                // 4: confa.chat.v1.Attachment
-	(*GetMessageHistoryRequest)(nil),  // 5: confa.chat.v1.GetMessageHistoryRequest
-	(*GetMessageHistoryResponse)(nil), // 6: confa.chat.v1.GetMessageHistoryResponse
-	(*GetMessageRequest)(nil),         // 7: confa.chat.v1.GetMessageRequest
-	(*GetMessageResponse)(nil),        // 8: confa.chat.v1.GetMessageResponse
-	(*StreamNewMessagesRequest)(nil),  // 9: confa.chat.v1.StreamNewMessagesRequest
-	(*StreamNewMessagesResponse)(nil), // 10: confa.chat.v1.StreamNewMessagesResponse
-	(*UploadAttachmentRequest)(nil),   // 11: confa.chat.v1.UploadAttachmentRequest
-	(*AttachmentUploadInfo)(nil),      // 12: confa.chat.v1.AttachmentUploadInfo
-	(*UploadAttachmentResponse)(nil),  // 13: confa.chat.v1.UploadAttachmentResponse
-	(*timestamppb.Timestamp)(nil),     // 14: google.protobuf.Timestamp
+	(SystemEventType)(0),                   // 0: confa.chat.v1.SystemEventType
+	(ContentNodeType)(0),                   // 1: confa.chat.v1.ContentNodeType
+	(MessageEventType)(0),                  // 2: confa.chat.v1.MessageEventType
+	(MentionKind)(0),                       // 3: confa.chat.v1.MentionKind
+	(*TextChannelRef)(nil),                 // 4: confa.chat.v1.TextChannelRef
+	(*SendMessageRequest)(nil),             // 5: confa.chat.v1.SendMessageRequest
+	(*SendMessageResponse)(nil),            // 6: confa.chat.v1.SendMessageResponse
+	(*Message)(nil),                        // 7: confa.chat.v1.Message
+	(*SystemEvent)(nil),                    // 8: confa.chat.v1.SystemEvent
+	(*LinkPreview)(nil),                    // 9: confa.chat.v1.LinkPreview
+	(*ContentNode)(nil),                    // 10: confa.chat.v1.ContentNode
+	(*Reaction)(nil),                       // 11: confa.chat.v1.Reaction
+	(*Attachment)(nil),                     // 12: confa.chat.v1.Attachment
+	(*GetMessageHistoryRequest)(nil),       // 13: confa.chat.v1.GetMessageHistoryRequest
+	(*GetMessageHistoryResponse)(nil),      // 14: confa.chat.v1.GetMessageHistoryResponse
+	(*GetMessageRequest)(nil),              // 15: confa.chat.v1.GetMessageRequest
+	(*GetMessageResponse)(nil),             // 16: confa.chat.v1.GetMessageResponse
+	(*StreamNewMessagesRequest)(nil),       // 17: confa.chat.v1.StreamNewMessagesRequest
+	(*StreamNewMessagesResponse)(nil),      // 18: confa.chat.v1.StreamNewMessagesResponse
+	(*MessageEvent)(nil),                   // 19: confa.chat.v1.MessageEvent
+	(*MessageCreatedEvent)(nil),            // 20: confa.chat.v1.MessageCreatedEvent
+	(*MessageEditedEvent)(nil),             // 21: confa.chat.v1.MessageEditedEvent
+	(*MessageDeletedEvent)(nil),            // 22: confa.chat.v1.MessageDeletedEvent
+	(*MessageThreadUpdatedEvent)(nil),      // 23: confa.chat.v1.MessageThreadUpdatedEvent
+	(*MessageReactionEvent)(nil),           // 24: confa.chat.v1.MessageReactionEvent
+	(*UploadAttachmentRequest)(nil),        // 25: confa.chat.v1.UploadAttachmentRequest
+	(*AttachmentUploadInfo)(nil),           // 26: confa.chat.v1.AttachmentUploadInfo
+	(*UploadAttachmentResponse)(nil),       // 27: confa.chat.v1.UploadAttachmentResponse
+	(*EditMessageRequest)(nil),             // 28: confa.chat.v1.EditMessageRequest
+	(*EditMessageResponse)(nil),            // 29: confa.chat.v1.EditMessageResponse
+	(*MessageRevision)(nil),                // 30: confa.chat.v1.MessageRevision
+	(*ListMessageRevisionsRequest)(nil),    // 31: confa.chat.v1.ListMessageRevisionsRequest
+	(*ListMessageRevisionsResponse)(nil),   // 32: confa.chat.v1.ListMessageRevisionsResponse
+	(*DeleteMessageRequest)(nil),           // 33: confa.chat.v1.DeleteMessageRequest
+	(*DeleteMessageResponse)(nil),          // 34: confa.chat.v1.DeleteMessageResponse
+	(*ListThreadMessagesRequest)(nil),      // 35: confa.chat.v1.ListThreadMessagesRequest
+	(*ListThreadMessagesResponse)(nil),     // 36: confa.chat.v1.ListThreadMessagesResponse
+	(*StreamThreadMessagesRequest)(nil),    // 37: confa.chat.v1.StreamThreadMessagesRequest
+	(*AddReactionRequest)(nil),             // 38: confa.chat.v1.AddReactionRequest
+	(*AddReactionResponse)(nil),            // 39: confa.chat.v1.AddReactionResponse
+	(*RemoveReactionRequest)(nil),          // 40: confa.chat.v1.RemoveReactionRequest
+	(*RemoveReactionResponse)(nil),         // 41: confa.chat.v1.RemoveReactionResponse
+	(*SearchMessagesRequest)(nil),          // 42: confa.chat.v1.SearchMessagesRequest
+	(*SearchMessagesResponse)(nil),         // 43: confa.chat.v1.SearchMessagesResponse
+	(*SearchResult)(nil),                   // 44: confa.chat.v1.SearchResult
+	(*Mention)(nil),                        // 45: confa.chat.v1.Mention
+	(*ListMentionsRequest)(nil),            // 46: confa.chat.v1.ListMentionsRequest
+	(*ListMentionsResponse)(nil),           // 47: confa.chat.v1.ListMentionsResponse
+	(*AcknowledgeMentionsRequest)(nil),     // 48: confa.chat.v1.AcknowledgeMentionsRequest
+	(*AcknowledgeMentionsResponse)(nil),    // 49: confa.chat.v1.AcknowledgeMentionsResponse
+	(*StreamMentionsRequest)(nil),          // 50: confa.chat.v1.StreamMentionsRequest
+	(*StreamMentionsResponse)(nil),         // 51: confa.chat.v1.StreamMentionsResponse
+	(*ReadState)(nil),                      // 52: confa.chat.v1.ReadState
+	(*MarkChannelReadRequest)(nil),         // 53: confa.chat.v1.MarkChannelReadRequest
+	(*MarkChannelReadResponse)(nil),        // 54: confa.chat.v1.MarkChannelReadResponse
+	(*StreamReadStateRequest)(nil),         // 55: confa.chat.v1.StreamReadStateRequest
+	(*StreamReadStateResponse)(nil),        // 56: confa.chat.v1.StreamReadStateResponse
+	(*TypingEvent)(nil),                    // 57: confa.chat.v1.TypingEvent
+	(*SetTypingRequest)(nil),               // 58: confa.chat.v1.SetTypingRequest
+	(*SetTypingResponse)(nil),              // 59: confa.chat.v1.SetTypingResponse
+	(*MessagePinEvent)(nil),                // 60: confa.chat.v1.MessagePinEvent
+	(*PinnedMessage)(nil),                  // 61: confa.chat.v1.PinnedMessage
+	(*PinMessageRequest)(nil),              // 62: confa.chat.v1.PinMessageRequest
+	(*PinMessageResponse)(nil),             // 63: confa.chat.v1.PinMessageResponse
+	(*UnpinMessageRequest)(nil),            // 64: confa.chat.v1.UnpinMessageRequest
+	(*UnpinMessageResponse)(nil),           // 65: confa.chat.v1.UnpinMessageResponse
+	(*ListPinnedMessagesRequest)(nil),      // 66: confa.chat.v1.ListPinnedMessagesRequest
+	(*ListPinnedMessagesResponse)(nil),     // 67: confa.chat.v1.ListPinnedMessagesResponse
+	(*ScheduledMessage)(nil),               // 68: confa.chat.v1.ScheduledMessage
+	(*ScheduleMessageRequest)(nil),         // 69: confa.chat.v1.ScheduleMessageRequest
+	(*ScheduleMessageResponse)(nil),        // 70: confa.chat.v1.ScheduleMessageResponse
+	(*ListScheduledMessagesRequest)(nil),   // 71: confa.chat.v1.ListScheduledMessagesRequest
+	(*ListScheduledMessagesResponse)(nil),  // 72: confa.chat.v1.ListScheduledMessagesResponse
+	(*CancelScheduledMessageRequest)(nil),  // 73: confa.chat.v1.CancelScheduledMessageRequest
+	(*CancelScheduledMessageResponse)(nil), // 74: confa.chat.v1.CancelScheduledMessageResponse
+	(*DirectMessage)(nil),                  // 75: confa.chat.v1.DirectMessage
+	(*OpenDirectMessageRequest)(nil),       // 76: confa.chat.v1.OpenDirectMessageRequest
+	(*OpenDirectMessageResponse)(nil),      // 77: confa.chat.v1.OpenDirectMessageResponse
+	(*ListDirectMessagesRequest)(nil),      // 78: confa.chat.v1.ListDirectMessagesRequest
+	(*ListDirectMessagesResponse)(nil),     // 79: confa.chat.v1.ListDirectMessagesResponse
+	(*CreateGroupRequest)(nil),             // 80: confa.chat.v1.CreateGroupRequest
+	(*CreateGroupResponse)(nil),            // 81: confa.chat.v1.CreateGroupResponse
+	(*AddGroupMembersRequest)(nil),         // 82: confa.chat.v1.AddGroupMembersRequest
+	(*AddGroupMembersResponse)(nil),        // 83: confa.chat.v1.AddGroupMembersResponse
+	(*RemoveGroupMemberRequest)(nil),       // 84: confa.chat.v1.RemoveGroupMemberRequest
+	(*RemoveGroupMemberResponse)(nil),      // 85: confa.chat.v1.RemoveGroupMemberResponse
+	(*RenameGroupRequest)(nil),             // 86: confa.chat.v1.RenameGroupRequest
+	(*RenameGroupResponse)(nil),            // 87: confa.chat.v1.RenameGroupResponse
+	(*LeaveGroupRequest)(nil),              // 88: confa.chat.v1.LeaveGroupRequest
+	(*LeaveGroupResponse)(nil),             // 89: confa.chat.v1.LeaveGroupResponse
+	(*durationpb.Duration)(nil),            // 90: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),          // 91: google.protobuf.Timestamp
+	(*v1.TextChannel)(nil),                 // 92: confa.channel.v1.TextChannel
 }
 var file_confa_chat_v1_service_proto_depIdxs = []int32{
-	0,  // 0: confa.chat.v1.SendMessageRequest.channel:type_name -> confa.chat.v1.TextChannelRef
-	14, // 1: confa.chat.v1.Message.timestamp:type_name -> google.protobuf.Timestamp
-	4,  // 2: confa.chat.v1.Message.attachments:type_name -> confa.chat.v1.Attachment
-	0,  // 3: confa.chat.v1.GetMessageHistoryRequest.channel:type_name -> confa.chat.v1.TextChannelRef
-	14, // 4: confa.chat.v1.GetMessageHistoryRequest.from:type_name -> google.protobuf.Timestamp
-	3,  // 5: confa.chat.v1.GetMessageHistoryResponse.messages:type_name -> confa.chat.v1.Message
-	0,  // 6: confa.chat.v1.GetMessageRequest.channel:type_name -> confa.chat.v1.TextChannelRef
-	3,  // 7: confa.chat.v1.GetMessageResponse.message:type_name -> confa.chat.v1.Message
-	0,  // 8: confa.chat.v1.StreamNewMessagesRequest.channel:type_name -> confa.chat.v1.TextChannelRef
-	12, // 9: confa.chat.v1.UploadAttachmentRequest.info:type_name -> confa.chat.v1.AttachmentUploadInfo
-	1,  // 10: confa.chat.v1.ChatService.SendMessage:input_type -> confa.chat.v1.SendMessageRequest
-	5,  // 11: confa.chat.v1.ChatService.GetMessageHistory:input_type -> confa.chat.v1.GetMessageHistoryRequest
-	7,  // 12: confa.chat.v1.ChatService.GetMessage:input_type -> confa.chat.v1.GetMessageRequest
-	9,  // 13: confa.chat.v1.ChatService.StreamNewMessages:input_type -> confa.chat.v1.StreamNewMessagesRequest
-	11, // 14: confa.chat.v1.ChatService.UploadAttachment:input_type -> confa.chat.v1.UploadAttachmentRequest
-	2,  // 15: confa.chat.v1.ChatService.SendMessage:output_type -> confa.chat.v1.SendMessageResponse
-	6,  // 16: confa.chat.v1.ChatService.GetMessageHistory:output_type -> confa.chat.v1.GetMessageHistoryResponse
-	8,  // 17: confa.chat.v1.ChatService.GetMessage:output_type -> confa.chat.v1.GetMessageResponse
-	10, // 18: confa.chat.v1.ChatService.StreamNewMessages:output_type -> confa.chat.v1.StreamNewMessagesResponse
-	13, // 19: confa.chat.v1.ChatService.UploadAttachment:output_type -> confa.chat.v1.UploadAttachmentResponse
-	15, // [15:20] is the sub-list for method output_type
-	10, // [10:15] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	4,   // 0: confa.chat.v1.SendMessageRequest.channel:type_name -> confa.chat.v1.TextChannelRef
+	90,  // 1: confa.chat.v1.SendMessageRequest.ttl:type_name -> google.protobuf.Duration
+	91,  // 2: confa.chat.v1.Message.timestamp:type_name -> google.protobuf.Timestamp
+	12,  // 3: confa.chat.v1.Message.attachments:type_name -> confa.chat.v1.Attachment
+	91,  // 4: confa.chat.v1.Message.edited_at:type_name -> google.protobuf.Timestamp
+	91,  // 5: confa.chat.v1.Message.deleted_at:type_name -> google.protobuf.Timestamp
+	11,  // 6: confa.chat.v1.Message.reactions:type_name -> confa.chat.v1.Reaction
+	10,  // 7: confa.chat.v1.Message.content_nodes:type_name -> confa.chat.v1.ContentNode
+	9,   // 8: confa.chat.v1.Message.previews:type_name -> confa.chat.v1.LinkPreview
+	91,  // 9: confa.chat.v1.Message.expires_at:type_name -> google.protobuf.Timestamp
+	8,   // 10: confa.chat.v1.Message.system_event:type_name -> confa.chat.v1.SystemEvent
+	0,   // 11: confa.chat.v1.SystemEvent.type:type_name -> confa.chat.v1.SystemEventType
+	1,   // 12: confa.chat.v1.ContentNode.type:type_name -> confa.chat.v1.ContentNodeType
+	10,  // 13: confa.chat.v1.ContentNode.children:type_name -> confa.chat.v1.ContentNode
+	4,   // 14: confa.chat.v1.GetMessageHistoryRequest.channel:type_name -> confa.chat.v1.TextChannelRef
+	91,  // 15: confa.chat.v1.GetMessageHistoryRequest.from:type_name -> google.protobuf.Timestamp
+	7,   // 16: confa.chat.v1.GetMessageHistoryResponse.messages:type_name -> confa.chat.v1.Message
+	4,   // 17: confa.chat.v1.GetMessageRequest.channel:type_name -> confa.chat.v1.TextChannelRef
+	7,   // 18: confa.chat.v1.GetMessageResponse.message:type_name -> confa.chat.v1.Message
+	4,   // 19: confa.chat.v1.StreamNewMessagesRequest.channel:type_name -> confa.chat.v1.TextChannelRef
+	2,   // 20: confa.chat.v1.StreamNewMessagesResponse.type:type_name -> confa.chat.v1.MessageEventType
+	19,  // 21: confa.chat.v1.StreamNewMessagesResponse.event:type_name -> confa.chat.v1.MessageEvent
+	20,  // 22: confa.chat.v1.MessageEvent.created:type_name -> confa.chat.v1.MessageCreatedEvent
+	21,  // 23: confa.chat.v1.MessageEvent.edited:type_name -> confa.chat.v1.MessageEditedEvent
+	22,  // 24: confa.chat.v1.MessageEvent.deleted:type_name -> confa.chat.v1.MessageDeletedEvent
+	23,  // 25: confa.chat.v1.MessageEvent.thread_updated:type_name -> confa.chat.v1.MessageThreadUpdatedEvent
+	24,  // 26: confa.chat.v1.MessageEvent.reaction:type_name -> confa.chat.v1.MessageReactionEvent
+	57,  // 27: confa.chat.v1.MessageEvent.typing:type_name -> confa.chat.v1.TypingEvent
+	60,  // 28: confa.chat.v1.MessageEvent.pin:type_name -> confa.chat.v1.MessagePinEvent
+	7,   // 29: confa.chat.v1.MessageCreatedEvent.message:type_name -> confa.chat.v1.Message
+	7,   // 30: confa.chat.v1.MessageEditedEvent.message:type_name -> confa.chat.v1.Message
+	7,   // 31: confa.chat.v1.MessageDeletedEvent.message:type_name -> confa.chat.v1.Message
+	7,   // 32: confa.chat.v1.MessageThreadUpdatedEvent.message:type_name -> confa.chat.v1.Message
+	26,  // 33: confa.chat.v1.UploadAttachmentRequest.info:type_name -> confa.chat.v1.AttachmentUploadInfo
+	4,   // 34: confa.chat.v1.EditMessageRequest.channel:type_name -> confa.chat.v1.TextChannelRef
+	7,   // 35: confa.chat.v1.EditMessageResponse.message:type_name -> confa.chat.v1.Message
+	91,  // 36: confa.chat.v1.MessageRevision.timestamp:type_name -> google.protobuf.Timestamp
+	4,   // 37: confa.chat.v1.ListMessageRevisionsRequest.channel:type_name -> confa.chat.v1.TextChannelRef
+	30,  // 38: confa.chat.v1.ListMessageRevisionsResponse.revisions:type_name -> confa.chat.v1.MessageRevision
+	4,   // 39: confa.chat.v1.DeleteMessageRequest.channel:type_name -> confa.chat.v1.TextChannelRef
+	4,   // 40: confa.chat.v1.ListThreadMessagesRequest.channel:type_name -> confa.chat.v1.TextChannelRef
+	7,   // 41: confa.chat.v1.ListThreadMessagesResponse.messages:type_name -> confa.chat.v1.Message
+	4,   // 42: confa.chat.v1.StreamThreadMessagesRequest.channel:type_name -> confa.chat.v1.TextChannelRef
+	4,   // 43: confa.chat.v1.AddReactionRequest.channel:type_name -> confa.chat.v1.TextChannelRef
+	4,   // 44: confa.chat.v1.RemoveReactionRequest.channel:type_name -> confa.chat.v1.TextChannelRef
+	91,  // 45: confa.chat.v1.SearchMessagesRequest.from:type_name -> google.protobuf.Timestamp
+	91,  // 46: confa.chat.v1.SearchMessagesRequest.to:type_name -> google.protobuf.Timestamp
+	44,  // 47: confa.chat.v1.SearchMessagesResponse.results:type_name -> confa.chat.v1.SearchResult
+	4,   // 48: confa.chat.v1.SearchResult.channel:type_name -> confa.chat.v1.TextChannelRef
+	7,   // 49: confa.chat.v1.SearchResult.message:type_name -> confa.chat.v1.Message
+	4,   // 50: confa.chat.v1.Mention.channel:type_name -> confa.chat.v1.TextChannelRef
+	7,   // 51: confa.chat.v1.Mention.message:type_name -> confa.chat.v1.Message
+	3,   // 52: confa.chat.v1.Mention.kind:type_name -> confa.chat.v1.MentionKind
+	91,  // 53: confa.chat.v1.Mention.timestamp:type_name -> google.protobuf.Timestamp
+	45,  // 54: confa.chat.v1.ListMentionsResponse.mentions:type_name -> confa.chat.v1.Mention
+	45,  // 55: confa.chat.v1.StreamMentionsResponse.mention:type_name -> confa.chat.v1.Mention
+	4,   // 56: confa.chat.v1.ReadState.channel:type_name -> confa.chat.v1.TextChannelRef
+	91,  // 57: confa.chat.v1.ReadState.updated_at:type_name -> google.protobuf.Timestamp
+	4,   // 58: confa.chat.v1.MarkChannelReadRequest.channel:type_name -> confa.chat.v1.TextChannelRef
+	52,  // 59: confa.chat.v1.MarkChannelReadResponse.read_state:type_name -> confa.chat.v1.ReadState
+	52,  // 60: confa.chat.v1.StreamReadStateResponse.read_state:type_name -> confa.chat.v1.ReadState
+	91,  // 61: confa.chat.v1.TypingEvent.expires_at:type_name -> google.protobuf.Timestamp
+	4,   // 62: confa.chat.v1.SetTypingRequest.channel:type_name -> confa.chat.v1.TextChannelRef
+	7,   // 63: confa.chat.v1.MessagePinEvent.message:type_name -> confa.chat.v1.Message
+	7,   // 64: confa.chat.v1.PinnedMessage.message:type_name -> confa.chat.v1.Message
+	91,  // 65: confa.chat.v1.PinnedMessage.pinned_at:type_name -> google.protobuf.Timestamp
+	4,   // 66: confa.chat.v1.PinMessageRequest.channel:type_name -> confa.chat.v1.TextChannelRef
+	4,   // 67: confa.chat.v1.UnpinMessageRequest.channel:type_name -> confa.chat.v1.TextChannelRef
+	4,   // 68: confa.chat.v1.ListPinnedMessagesRequest.channel:type_name -> confa.chat.v1.TextChannelRef
+	61,  // 69: confa.chat.v1.ListPinnedMessagesResponse.pins:type_name -> confa.chat.v1.PinnedMessage
+	4,   // 70: confa.chat.v1.ScheduledMessage.channel:type_name -> confa.chat.v1.TextChannelRef
+	91,  // 71: confa.chat.v1.ScheduledMessage.send_at:type_name -> google.protobuf.Timestamp
+	91,  // 72: confa.chat.v1.ScheduledMessage.created_at:type_name -> google.protobuf.Timestamp
+	4,   // 73: confa.chat.v1.ScheduleMessageRequest.channel:type_name -> confa.chat.v1.TextChannelRef
+	91,  // 74: confa.chat.v1.ScheduleMessageRequest.send_at:type_name -> google.protobuf.Timestamp
+	68,  // 75: confa.chat.v1.ScheduleMessageResponse.scheduled_message:type_name -> confa.chat.v1.ScheduledMessage
+	4,   // 76: confa.chat.v1.ListScheduledMessagesRequest.channel:type_name -> confa.chat.v1.TextChannelRef
+	68,  // 77: confa.chat.v1.ListScheduledMessagesResponse.scheduled_messages:type_name -> confa.chat.v1.ScheduledMessage
+	92,  // 78: confa.chat.v1.DirectMessage.channel:type_name -> confa.channel.v1.TextChannel
+	91,  // 79: confa.chat.v1.DirectMessage.last_activity_at:type_name -> google.protobuf.Timestamp
+	75,  // 80: confa.chat.v1.OpenDirectMessageResponse.direct_message:type_name -> confa.chat.v1.DirectMessage
+	75,  // 81: confa.chat.v1.ListDirectMessagesResponse.direct_messages:type_name -> confa.chat.v1.DirectMessage
+	75,  // 82: confa.chat.v1.CreateGroupResponse.direct_message:type_name -> confa.chat.v1.DirectMessage
+	75,  // 83: confa.chat.v1.AddGroupMembersResponse.direct_message:type_name -> confa.chat.v1.DirectMessage
+	75,  // 84: confa.chat.v1.RenameGroupResponse.direct_message:type_name -> confa.chat.v1.DirectMessage
+	5,   // 85: confa.chat.v1.ChatService.SendMessage:input_type -> confa.chat.v1.SendMessageRequest
+	13,  // 86: confa.chat.v1.ChatService.GetMessageHistory:input_type -> confa.chat.v1.GetMessageHistoryRequest
+	15,  // 87: confa.chat.v1.ChatService.GetMessage:input_type -> confa.chat.v1.GetMessageRequest
+	17,  // 88: confa.chat.v1.ChatService.StreamNewMessages:input_type -> confa.chat.v1.StreamNewMessagesRequest
+	25,  // 89: confa.chat.v1.ChatService.UploadAttachment:input_type -> confa.chat.v1.UploadAttachmentRequest
+	28,  // 90: confa.chat.v1.ChatService.EditMessage:input_type -> confa.chat.v1.EditMessageRequest
+	31,  // 91: confa.chat.v1.ChatService.ListMessageRevisions:input_type -> confa.chat.v1.ListMessageRevisionsRequest
+	33,  // 92: confa.chat.v1.ChatService.DeleteMessage:input_type -> confa.chat.v1.DeleteMessageRequest
+	35,  // 93: confa.chat.v1.ChatService.ListThreadMessages:input_type -> confa.chat.v1.ListThreadMessagesRequest
+	37,  // 94: confa.chat.v1.ChatService.StreamThreadMessages:input_type -> confa.chat.v1.StreamThreadMessagesRequest
+	38,  // 95: confa.chat.v1.ChatService.AddReaction:input_type -> confa.chat.v1.AddReactionRequest
+	40,  // 96: confa.chat.v1.ChatService.RemoveReaction:input_type -> confa.chat.v1.RemoveReactionRequest
+	42,  // 97: confa.chat.v1.ChatService.SearchMessages:input_type -> confa.chat.v1.SearchMessagesRequest
+	46,  // 98: confa.chat.v1.ChatService.ListMentions:input_type -> confa.chat.v1.ListMentionsRequest
+	48,  // 99: confa.chat.v1.ChatService.AcknowledgeMentions:input_type -> confa.chat.v1.AcknowledgeMentionsRequest
+	50,  // 100: confa.chat.v1.ChatService.StreamMentions:input_type -> confa.chat.v1.StreamMentionsRequest
+	53,  // 101: confa.chat.v1.ChatService.MarkChannelRead:input_type -> confa.chat.v1.MarkChannelReadRequest
+	55,  // 102: confa.chat.v1.ChatService.StreamReadState:input_type -> confa.chat.v1.StreamReadStateRequest
+	58,  // 103: confa.chat.v1.ChatService.SetTyping:input_type -> confa.chat.v1.SetTypingRequest
+	62,  // 104: confa.chat.v1.ChatService.PinMessage:input_type -> confa.chat.v1.PinMessageRequest
+	64,  // 105: confa.chat.v1.ChatService.UnpinMessage:input_type -> confa.chat.v1.UnpinMessageRequest
+	66,  // 106: confa.chat.v1.ChatService.ListPinnedMessages:input_type -> confa.chat.v1.ListPinnedMessagesRequest
+	69,  // 107: confa.chat.v1.ChatService.ScheduleMessage:input_type -> confa.chat.v1.ScheduleMessageRequest
+	71,  // 108: confa.chat.v1.ChatService.ListScheduledMessages:input_type -> confa.chat.v1.ListScheduledMessagesRequest
+	73,  // 109: confa.chat.v1.ChatService.CancelScheduledMessage:input_type -> confa.chat.v1.CancelScheduledMessageRequest
+	76,  // 110: confa.chat.v1.ChatService.OpenDirectMessage:input_type -> confa.chat.v1.OpenDirectMessageRequest
+	78,  // 111: confa.chat.v1.ChatService.ListDirectMessages:input_type -> confa.chat.v1.ListDirectMessagesRequest
+	80,  // 112: confa.chat.v1.ChatService.CreateGroup:input_type -> confa.chat.v1.CreateGroupRequest
+	82,  // 113: confa.chat.v1.ChatService.AddGroupMembers:input_type -> confa.chat.v1.AddGroupMembersRequest
+	84,  // 114: confa.chat.v1.ChatService.RemoveGroupMember:input_type -> confa.chat.v1.RemoveGroupMemberRequest
+	86,  // 115: confa.chat.v1.ChatService.RenameGroup:input_type -> confa.chat.v1.RenameGroupRequest
+	88,  // 116: confa.chat.v1.ChatService.LeaveGroup:input_type -> confa.chat.v1.LeaveGroupRequest
+	6,   // 117: confa.chat.v1.ChatService.SendMessage:output_type -> confa.chat.v1.SendMessageResponse
+	14,  // 118: confa.chat.v1.ChatService.GetMessageHistory:output_type -> confa.chat.v1.GetMessageHistoryResponse
+	16,  // 119: confa.chat.v1.ChatService.GetMessage:output_type -> confa.chat.v1.GetMessageResponse
+	18,  // 120: confa.chat.v1.ChatService.StreamNewMessages:output_type -> confa.chat.v1.StreamNewMessagesResponse
+	27,  // 121: confa.chat.v1.ChatService.UploadAttachment:output_type -> confa.chat.v1.UploadAttachmentResponse
+	29,  // 122: confa.chat.v1.ChatService.EditMessage:output_type -> confa.chat.v1.EditMessageResponse
+	32,  // 123: confa.chat.v1.ChatService.ListMessageRevisions:output_type -> confa.chat.v1.ListMessageRevisionsResponse
+	34,  // 124: confa.chat.v1.ChatService.DeleteMessage:output_type -> confa.chat.v1.DeleteMessageResponse
+	36,  // 125: confa.chat.v1.ChatService.ListThreadMessages:output_type -> confa.chat.v1.ListThreadMessagesResponse
+	18,  // 126: confa.chat.v1.ChatService.StreamThreadMessages:output_type -> confa.chat.v1.StreamNewMessagesResponse
+	39,  // 127: confa.chat.v1.ChatService.AddReaction:output_type -> confa.chat.v1.AddReactionResponse
+	41,  // 128: confa.chat.v1.ChatService.RemoveReaction:output_type -> confa.chat.v1.RemoveReactionResponse
+	43,  // 129: confa.chat.v1.ChatService.SearchMessages:output_type -> confa.chat.v1.SearchMessagesResponse
+	47,  // 130: confa.chat.v1.ChatService.ListMentions:output_type -> confa.chat.v1.ListMentionsResponse
+	49,  // 131: confa.chat.v1.ChatService.AcknowledgeMentions:output_type -> confa.chat.v1.AcknowledgeMentionsResponse
+	51,  // 132: confa.chat.v1.ChatService.StreamMentions:output_type -> confa.chat.v1.StreamMentionsResponse
+	54,  // 133: confa.chat.v1.ChatService.MarkChannelRead:output_type -> confa.chat.v1.MarkChannelReadResponse
+	56,  // 134: confa.chat.v1.ChatService.StreamReadState:output_type -> confa.chat.v1.StreamReadStateResponse
+	59,  // 135: confa.chat.v1.ChatService.SetTyping:output_type -> confa.chat.v1.SetTypingResponse
+	63,  // 136: confa.chat.v1.ChatService.PinMessage:output_type -> confa.chat.v1.PinMessageResponse
+	65,  // 137: confa.chat.v1.ChatService.UnpinMessage:output_type -> confa.chat.v1.UnpinMessageResponse
+	67,  // 138: confa.chat.v1.ChatService.ListPinnedMessages:output_type -> confa.chat.v1.ListPinnedMessagesResponse
+	70,  // 139: confa.chat.v1.ChatService.ScheduleMessage:output_type -> confa.chat.v1.ScheduleMessageResponse
+	72,  // 140: confa.chat.v1.ChatService.ListScheduledMessages:output_type -> confa.chat.v1.ListScheduledMessagesResponse
+	74,  // 141: confa.chat.v1.ChatService.CancelScheduledMessage:output_type -> confa.chat.v1.CancelScheduledMessageResponse
+	77,  // 142: confa.chat.v1.ChatService.OpenDirectMessage:output_type -> confa.chat.v1.OpenDirectMessageResponse
+	79,  // 143: confa.chat.v1.ChatService.ListDirectMessages:output_type -> confa.chat.v1.ListDirectMessagesResponse
+	81,  // 144: confa.chat.v1.ChatService.CreateGroup:output_type -> confa.chat.v1.CreateGroupResponse
+	83,  // 145: confa.chat.v1.ChatService.AddGroupMembers:output_type -> confa.chat.v1.AddGroupMembersResponse
+	85,  // 146: confa.chat.v1.ChatService.RemoveGroupMember:output_type -> confa.chat.v1.RemoveGroupMemberResponse
+	87,  // 147: confa.chat.v1.ChatService.RenameGroup:output_type -> confa.chat.v1.RenameGroupResponse
+	89,  // 148: confa.chat.v1.ChatService.LeaveGroup:output_type -> confa.chat.v1.LeaveGroupResponse
+	117, // [117:149] is the sub-list for method output_type
+	85,  // [85:117] is the sub-list for method input_type
+	85,  // [85:85] is the sub-list for extension type_name
+	85,  // [85:85] is the sub-list for extension extendee
+	0,   // [0:85] is the sub-list for field type_name
 }
 
 func init() { file_confa_chat_v1_service_proto_init() }
@@ -897,22 +5693,38 @@ func file_confa_chat_v1_service_proto_init() {
 	if File_confa_chat_v1_service_proto != nil {
 		return
 	}
-	file_confa_chat_v1_service_proto_msgTypes[11].OneofWrappers = []any{
+	file_confa_chat_v1_service_proto_msgTypes[9].OneofWrappers = []any{
+		(*GetMessageHistoryRequest_BeforeId)(nil),
+		(*GetMessageHistoryRequest_AfterId)(nil),
+		(*GetMessageHistoryRequest_AroundId)(nil),
+	}
+	file_confa_chat_v1_service_proto_msgTypes[15].OneofWrappers = []any{
+		(*MessageEvent_Created)(nil),
+		(*MessageEvent_Edited)(nil),
+		(*MessageEvent_Deleted)(nil),
+		(*MessageEvent_ThreadUpdated)(nil),
+		(*MessageEvent_Reaction)(nil),
+		(*MessageEvent_Typing)(nil),
+		(*MessageEvent_Pin)(nil),
+	}
+	file_confa_chat_v1_service_proto_msgTypes[21].OneofWrappers = []any{
 		(*UploadAttachmentRequest_Info)(nil),
 		(*UploadAttachmentRequest_Data)(nil),
 	}
+	file_confa_chat_v1_service_proto_msgTypes[38].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_confa_chat_v1_service_proto_rawDesc), len(file_confa_chat_v1_service_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   14,
+			NumEnums:      4,
+			NumMessages:   86,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_confa_chat_v1_service_proto_goTypes,
 		DependencyIndexes: file_confa_chat_v1_service_proto_depIdxs,
+		EnumInfos:         file_confa_chat_v1_service_proto_enumTypes,
 		MessageInfos:      file_confa_chat_v1_service_proto_msgTypes,
 	}.Build()
 	File_confa_chat_v1_service_proto = out.File
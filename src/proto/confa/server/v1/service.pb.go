@@ -11,6 +11,8 @@ import (
 	v11 "github.com/confa-chat/node/src/proto/confa/user/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -23,6 +25,134 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type Permission int32
+
+const (
+	Permission_PERMISSION_UNSPECIFIED     Permission = 0
+	Permission_PERMISSION_VIEW_CHANNEL    Permission = 1
+	Permission_PERMISSION_SEND_MESSAGES   Permission = 2
+	Permission_PERMISSION_ADD_REACTIONS   Permission = 4
+	Permission_PERMISSION_CREATE_INVITES  Permission = 8
+	Permission_PERMISSION_MANAGE_MESSAGES Permission = 16
+	Permission_PERMISSION_MANAGE_CHANNELS Permission = 32
+	Permission_PERMISSION_MANAGE_ROLES    Permission = 64
+	Permission_PERMISSION_MANAGE_SERVER   Permission = 128
+	Permission_PERMISSION_KICK_MEMBERS    Permission = 256
+	Permission_PERMISSION_BAN_MEMBERS     Permission = 512
+	Permission_PERMISSION_MUTE_MEMBERS    Permission = 1024
+	Permission_PERMISSION_ADMINISTRATOR   Permission = 2048
+)
+
+// Enum value maps for Permission.
+var (
+	Permission_name = map[int32]string{
+		0:    "PERMISSION_UNSPECIFIED",
+		1:    "PERMISSION_VIEW_CHANNEL",
+		2:    "PERMISSION_SEND_MESSAGES",
+		4:    "PERMISSION_ADD_REACTIONS",
+		8:    "PERMISSION_CREATE_INVITES",
+		16:   "PERMISSION_MANAGE_MESSAGES",
+		32:   "PERMISSION_MANAGE_CHANNELS",
+		64:   "PERMISSION_MANAGE_ROLES",
+		128:  "PERMISSION_MANAGE_SERVER",
+		256:  "PERMISSION_KICK_MEMBERS",
+		512:  "PERMISSION_BAN_MEMBERS",
+		1024: "PERMISSION_MUTE_MEMBERS",
+		2048: "PERMISSION_ADMINISTRATOR",
+	}
+	Permission_value = map[string]int32{
+		"PERMISSION_UNSPECIFIED":     0,
+		"PERMISSION_VIEW_CHANNEL":    1,
+		"PERMISSION_SEND_MESSAGES":   2,
+		"PERMISSION_ADD_REACTIONS":   4,
+		"PERMISSION_CREATE_INVITES":  8,
+		"PERMISSION_MANAGE_MESSAGES": 16,
+		"PERMISSION_MANAGE_CHANNELS": 32,
+		"PERMISSION_MANAGE_ROLES":    64,
+		"PERMISSION_MANAGE_SERVER":   128,
+		"PERMISSION_KICK_MEMBERS":    256,
+		"PERMISSION_BAN_MEMBERS":     512,
+		"PERMISSION_MUTE_MEMBERS":    1024,
+		"PERMISSION_ADMINISTRATOR":   2048,
+	}
+)
+
+func (x Permission) Enum() *Permission {
+	p := new(Permission)
+	*p = x
+	return p
+}
+
+func (x Permission) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Permission) Descriptor() protoreflect.EnumDescriptor {
+	return file_confa_server_v1_service_proto_enumTypes[0].Descriptor()
+}
+
+func (Permission) Type() protoreflect.EnumType {
+	return &file_confa_server_v1_service_proto_enumTypes[0]
+}
+
+func (x Permission) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Permission.Descriptor instead.
+func (Permission) EnumDescriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{0}
+}
+
+type OverwriteTarget int32
+
+const (
+	OverwriteTarget_OVERWRITE_TARGET_UNSPECIFIED OverwriteTarget = 0
+	OverwriteTarget_OVERWRITE_TARGET_ROLE        OverwriteTarget = 1
+	OverwriteTarget_OVERWRITE_TARGET_MEMBER      OverwriteTarget = 2
+)
+
+// Enum value maps for OverwriteTarget.
+var (
+	OverwriteTarget_name = map[int32]string{
+		0: "OVERWRITE_TARGET_UNSPECIFIED",
+		1: "OVERWRITE_TARGET_ROLE",
+		2: "OVERWRITE_TARGET_MEMBER",
+	}
+	OverwriteTarget_value = map[string]int32{
+		"OVERWRITE_TARGET_UNSPECIFIED": 0,
+		"OVERWRITE_TARGET_ROLE":        1,
+		"OVERWRITE_TARGET_MEMBER":      2,
+	}
+)
+
+func (x OverwriteTarget) Enum() *OverwriteTarget {
+	p := new(OverwriteTarget)
+	*p = x
+	return p
+}
+
+func (x OverwriteTarget) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OverwriteTarget) Descriptor() protoreflect.EnumDescriptor {
+	return file_confa_server_v1_service_proto_enumTypes[1].Descriptor()
+}
+
+func (OverwriteTarget) Type() protoreflect.EnumType {
+	return &file_confa_server_v1_service_proto_enumTypes[1]
+}
+
+func (x OverwriteTarget) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OverwriteTarget.Descriptor instead.
+func (OverwriteTarget) EnumDescriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{1}
+}
+
 type CreateChannelRequest_ChannelType int32
 
 const (
@@ -53,11 +183,11 @@ func (x CreateChannelRequest_ChannelType) String() string {
 }
 
 func (CreateChannelRequest_ChannelType) Descriptor() protoreflect.EnumDescriptor {
-	return file_confa_server_v1_service_proto_enumTypes[0].Descriptor()
+	return file_confa_server_v1_service_proto_enumTypes[2].Descriptor()
 }
 
 func (CreateChannelRequest_ChannelType) Type() protoreflect.EnumType {
-	return &file_confa_server_v1_service_proto_enumTypes[0]
+	return &file_confa_server_v1_service_proto_enumTypes[2]
 }
 
 func (x CreateChannelRequest_ChannelType) Number() protoreflect.EnumNumber {
@@ -66,7 +196,7 @@ func (x CreateChannelRequest_ChannelType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CreateChannelRequest_ChannelType.Descriptor instead.
 func (CreateChannelRequest_ChannelType) EnumDescriptor() ([]byte, []int) {
-	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{4, 0}
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{5, 0}
 }
 
 type EditChannelRequest_ChannelType int32
@@ -99,11 +229,11 @@ func (x EditChannelRequest_ChannelType) String() string {
 }
 
 func (EditChannelRequest_ChannelType) Descriptor() protoreflect.EnumDescriptor {
-	return file_confa_server_v1_service_proto_enumTypes[1].Descriptor()
+	return file_confa_server_v1_service_proto_enumTypes[3].Descriptor()
 }
 
 func (EditChannelRequest_ChannelType) Type() protoreflect.EnumType {
-	return &file_confa_server_v1_service_proto_enumTypes[1]
+	return &file_confa_server_v1_service_proto_enumTypes[3]
 }
 
 func (x EditChannelRequest_ChannelType) Number() protoreflect.EnumNumber {
@@ -112,7 +242,7 @@ func (x EditChannelRequest_ChannelType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use EditChannelRequest_ChannelType.Descriptor instead.
 func (EditChannelRequest_ChannelType) EnumDescriptor() ([]byte, []int) {
-	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{6, 0}
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{7, 0}
 }
 
 type ListChannelsRequest struct {
@@ -250,6 +380,7 @@ func (x *ListUsersRequest) GetServerId() string {
 type ListUsersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Users         []*v11.User            `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	Members       []*ServerMember        `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -291,6 +422,81 @@ func (x *ListUsersResponse) GetUsers() []*v11.User {
 	return nil
 }
 
+func (x *ListUsersResponse) GetMembers() []*ServerMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type ServerMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *v11.User              `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Nickname      string                 `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	JoinedAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
+	RoleIds       []string               `protobuf:"bytes,4,rep,name=role_ids,json=roleIds,proto3" json:"role_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServerMember) Reset() {
+	*x = ServerMember{}
+	mi := &file_confa_server_v1_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServerMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerMember) ProtoMessage() {}
+
+func (x *ServerMember) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_server_v1_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerMember.ProtoReflect.Descriptor instead.
+func (*ServerMember) Descriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ServerMember) GetUser() *v11.User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *ServerMember) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *ServerMember) GetJoinedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.JoinedAt
+	}
+	return nil
+}
+
+func (x *ServerMember) GetRoleIds() []string {
+	if x != nil {
+		return x.RoleIds
+	}
+	return nil
+}
+
 type CreateChannelRequest struct {
 	state         protoimpl.MessageState           `protogen:"open.v1"`
 	ServerId      string                           `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
@@ -302,7 +508,7 @@ type CreateChannelRequest struct {
 
 func (x *CreateChannelRequest) Reset() {
 	*x = CreateChannelRequest{}
-	mi := &file_confa_server_v1_service_proto_msgTypes[4]
+	mi := &file_confa_server_v1_service_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -314,7 +520,7 @@ func (x *CreateChannelRequest) String() string {
 func (*CreateChannelRequest) ProtoMessage() {}
 
 func (x *CreateChannelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_confa_server_v1_service_proto_msgTypes[4]
+	mi := &file_confa_server_v1_service_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -327,7 +533,7 @@ func (x *CreateChannelRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateChannelRequest.ProtoReflect.Descriptor instead.
 func (*CreateChannelRequest) Descriptor() ([]byte, []int) {
-	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{4}
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *CreateChannelRequest) GetServerId() string {
@@ -360,7 +566,7 @@ type CreateChannelResponse struct {
 
 func (x *CreateChannelResponse) Reset() {
 	*x = CreateChannelResponse{}
-	mi := &file_confa_server_v1_service_proto_msgTypes[5]
+	mi := &file_confa_server_v1_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -372,7 +578,7 @@ func (x *CreateChannelResponse) String() string {
 func (*CreateChannelResponse) ProtoMessage() {}
 
 func (x *CreateChannelResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_confa_server_v1_service_proto_msgTypes[5]
+	mi := &file_confa_server_v1_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -385,7 +591,7 @@ func (x *CreateChannelResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateChannelResponse.ProtoReflect.Descriptor instead.
 func (*CreateChannelResponse) Descriptor() ([]byte, []int) {
-	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{5}
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *CreateChannelResponse) GetChannel() *v1.Channel {
@@ -407,7 +613,7 @@ type EditChannelRequest struct {
 
 func (x *EditChannelRequest) Reset() {
 	*x = EditChannelRequest{}
-	mi := &file_confa_server_v1_service_proto_msgTypes[6]
+	mi := &file_confa_server_v1_service_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -419,7 +625,7 @@ func (x *EditChannelRequest) String() string {
 func (*EditChannelRequest) ProtoMessage() {}
 
 func (x *EditChannelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_confa_server_v1_service_proto_msgTypes[6]
+	mi := &file_confa_server_v1_service_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -432,7 +638,7 @@ func (x *EditChannelRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EditChannelRequest.ProtoReflect.Descriptor instead.
 func (*EditChannelRequest) Descriptor() ([]byte, []int) {
-	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{6}
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *EditChannelRequest) GetServerId() string {
@@ -472,7 +678,7 @@ type EditChannelResponse struct {
 
 func (x *EditChannelResponse) Reset() {
 	*x = EditChannelResponse{}
-	mi := &file_confa_server_v1_service_proto_msgTypes[7]
+	mi := &file_confa_server_v1_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -484,7 +690,7 @@ func (x *EditChannelResponse) String() string {
 func (*EditChannelResponse) ProtoMessage() {}
 
 func (x *EditChannelResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_confa_server_v1_service_proto_msgTypes[7]
+	mi := &file_confa_server_v1_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -497,7 +703,7 @@ func (x *EditChannelResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EditChannelResponse.ProtoReflect.Descriptor instead.
 func (*EditChannelResponse) Descriptor() ([]byte, []int) {
-	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{7}
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *EditChannelResponse) GetChannel() *v1.Channel {
@@ -507,44 +713,3456 @@ func (x *EditChannelResponse) GetChannel() *v1.Channel {
 	return nil
 }
 
-var File_confa_server_v1_service_proto protoreflect.FileDescriptor
+type SetChannelMessageTTLRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	ChannelId     string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	MessageTtl    *durationpb.Duration   `protobuf:"bytes,3,opt,name=message_ttl,json=messageTtl,proto3" json:"message_ttl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_confa_server_v1_service_proto_rawDesc = "" +
-	"\n" +
-	"\x1dconfa/server/v1/service.proto\x12\x0fconfa.server.v1\x1a\x18confa/user/v1/user.proto\x1a\x1fconfa/channel/v1/channels.proto\"2\n" +
-	"\x13ListChannelsRequest\x12\x1b\n" +
-	"\tserver_id\x18\x01 \x01(\tR\bserverId\"M\n" +
-	"\x14ListChannelsResponse\x125\n" +
-	"\bchannels\x18\x01 \x03(\v2\x19.confa.channel.v1.ChannelR\bchannels\"/\n" +
-	"\x10ListUsersRequest\x12\x1b\n" +
-	"\tserver_id\x18\x01 \x01(\tR\bserverId\">\n" +
-	"\x11ListUsersResponse\x12)\n" +
-	"\x05users\x18\x01 \x03(\v2\x13.confa.user.v1.UserR\x05users\"\xb2\x01\n" +
-	"\x14CreateChannelRequest\x12\x1b\n" +
-	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12E\n" +
-	"\x04type\x18\x03 \x01(\x0e21.confa.server.v1.CreateChannelRequest.ChannelTypeR\x04type\"\"\n" +
-	"\vChannelType\x12\b\n" +
-	"\x04TEXT\x10\x00\x12\t\n" +
-	"\x05VOICE\x10\x01\"L\n" +
-	"\x15CreateChannelResponse\x123\n" +
-	"\achannel\x18\x01 \x01(\v2\x19.confa.channel.v1.ChannelR\achannel\"\xcd\x01\n" +
-	"\x12EditChannelRequest\x12\x1b\n" +
-	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12\x1d\n" +
-	"\n" +
-	"channel_id\x18\x02 \x01(\tR\tchannelId\x12\x12\n" +
-	"\x04name\x18\x03 \x01(\tR\x04name\x12C\n" +
-	"\x04type\x18\x04 \x01(\x0e2/.confa.server.v1.EditChannelRequest.ChannelTypeR\x04type\"\"\n" +
-	"\vChannelType\x12\b\n" +
-	"\x04TEXT\x10\x00\x12\t\n" +
-	"\x05VOICE\x10\x01\"J\n" +
-	"\x13EditChannelResponse\x123\n" +
-	"\achannel\x18\x01 \x01(\v2\x19.confa.channel.v1.ChannelR\achannel2\x82\x03\n" +
-	"\rServerService\x12]\n" +
-	"\fListChannels\x12$.confa.server.v1.ListChannelsRequest\x1a%.confa.server.v1.ListChannelsResponse\"\x00\x12T\n" +
-	"\tListUsers\x12!.confa.server.v1.ListUsersRequest\x1a\".confa.server.v1.ListUsersResponse\"\x00\x12`\n" +
-	"\rCreateChannel\x12%.confa.server.v1.CreateChannelRequest\x1a&.confa.server.v1.CreateChannelResponse\"\x00\x12Z\n" +
-	"\vEditChannel\x12#.confa.server.v1.EditChannelRequest\x1a$.confa.server.v1.EditChannelResponse\"\x00B\xc0\x01\n" +
+func (x *SetChannelMessageTTLRequest) Reset() {
+	*x = SetChannelMessageTTLRequest{}
+	mi := &file_confa_server_v1_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetChannelMessageTTLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetChannelMessageTTLRequest) ProtoMessage() {}
+
+func (x *SetChannelMessageTTLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_server_v1_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetChannelMessageTTLRequest.ProtoReflect.Descriptor instead.
+func (*SetChannelMessageTTLRequest) Descriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SetChannelMessageTTLRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *SetChannelMessageTTLRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *SetChannelMessageTTLRequest) GetMessageTtl() *durationpb.Duration {
+	if x != nil {
+		return x.MessageTtl
+	}
+	return nil
+}
+
+type SetChannelMessageTTLResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       *v1.Channel            `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetChannelMessageTTLResponse) Reset() {
+	*x = SetChannelMessageTTLResponse{}
+	mi := &file_confa_server_v1_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetChannelMessageTTLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetChannelMessageTTLResponse) ProtoMessage() {}
+
+func (x *SetChannelMessageTTLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_server_v1_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetChannelMessageTTLResponse.ProtoReflect.Descriptor instead.
+func (*SetChannelMessageTTLResponse) Descriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SetChannelMessageTTLResponse) GetChannel() *v1.Channel {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+type ExportChannelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	ChannelId     string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportChannelRequest) Reset() {
+	*x = ExportChannelRequest{}
+	mi := &file_confa_server_v1_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportChannelRequest) ProtoMessage() {}
+
+func (x *ExportChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_server_v1_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportChannelRequest.ProtoReflect.Descriptor instead.
+func (*ExportChannelRequest) Descriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ExportChannelRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *ExportChannelRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+type ExportChannelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chunk         []byte                 `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportChannelResponse) Reset() {
+	*x = ExportChannelResponse{}
+	mi := &file_confa_server_v1_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportChannelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportChannelResponse) ProtoMessage() {}
+
+func (x *ExportChannelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_server_v1_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportChannelResponse.ProtoReflect.Descriptor instead.
+func (*ExportChannelResponse) Descriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ExportChannelResponse) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+type LeaveServerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveServerRequest) Reset() {
+	*x = LeaveServerRequest{}
+	mi := &file_confa_server_v1_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveServerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveServerRequest) ProtoMessage() {}
+
+func (x *LeaveServerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_server_v1_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveServerRequest.ProtoReflect.Descriptor instead.
+func (*LeaveServerRequest) Descriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *LeaveServerRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+type LeaveServerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveServerResponse) Reset() {
+	*x = LeaveServerResponse{}
+	mi := &file_confa_server_v1_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveServerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveServerResponse) ProtoMessage() {}
+
+func (x *LeaveServerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_server_v1_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveServerResponse.ProtoReflect.Descriptor instead.
+func (*LeaveServerResponse) Descriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{14}
+}
+
+type SetNicknameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	Nickname      string                 `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetNicknameRequest) Reset() {
+	*x = SetNicknameRequest{}
+	mi := &file_confa_server_v1_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetNicknameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNicknameRequest) ProtoMessage() {}
+
+func (x *SetNicknameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_server_v1_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNicknameRequest.ProtoReflect.Descriptor instead.
+func (*SetNicknameRequest) Descriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SetNicknameRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *SetNicknameRequest) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+type SetNicknameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *ServerMember          `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetNicknameResponse) Reset() {
+	*x = SetNicknameResponse{}
+	mi := &file_confa_server_v1_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetNicknameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNicknameResponse) ProtoMessage() {}
+
+func (x *SetNicknameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_server_v1_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNicknameResponse.ProtoReflect.Descriptor instead.
+func (*SetNicknameResponse) Descriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SetNicknameResponse) GetMember() *ServerMember {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+type Invite struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	ServerId      string                 `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	ChannelId     string                 `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	CreatorId     string                 `protobuf:"bytes,4,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	MaxUses       int32                  `protobuf:"varint,5,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`
+	Uses          int32                  `protobuf:"varint,6,opt,name=uses,proto3" json:"uses,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Invite) Reset() {
+	*x = Invite{}
+	mi := &file_confa_server_v1_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Invite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invite) ProtoMessage() {}
+
+func (x *Invite) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_server_v1_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invite.ProtoReflect.Descriptor instead.
+func (*Invite) Descriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Invite) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Invite) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *Invite) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *Invite) GetCreatorId() string {
+	if x != nil {
+		return x.CreatorId
+	}
+	return ""
+}
+
+func (x *Invite) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *Invite) GetUses() int32 {
+	if x != nil {
+		return x.Uses
+	}
+	return 0
+}
+
+func (x *Invite) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Invite) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateInviteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	ChannelId     string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	MaxUses       int32                  `protobuf:"varint,3,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`
+	ExpiresIn     *durationpb.Duration   `protobuf:"bytes,4,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateInviteRequest) Reset() {
+	*x = CreateInviteRequest{}
+	mi := &file_confa_server_v1_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInviteRequest) ProtoMessage() {}
+
+func (x *CreateInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_server_v1_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInviteRequest.ProtoReflect.Descriptor instead.
+func (*CreateInviteRequest) Descriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CreateInviteRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *CreateInviteRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *CreateInviteRequest) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *CreateInviteRequest) GetExpiresIn() *durationpb.Duration {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return nil
+}
+
+type CreateInviteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invite        *Invite                `protobuf:"bytes,1,opt,name=invite,proto3" json:"invite,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateInviteResponse) Reset() {
+	*x = CreateInviteResponse{}
+	mi := &file_confa_server_v1_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInviteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInviteResponse) ProtoMessage() {}
+
+func (x *CreateInviteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_server_v1_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInviteResponse.ProtoReflect.Descriptor instead.
+func (*CreateInviteResponse) Descriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CreateInviteResponse) GetInvite() *Invite {
+	if x != nil {
+		return x.Invite
+	}
+	return nil
+}
+
+type ListInvitesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvitesRequest) Reset() {
+	*x = ListInvitesRequest{}
+	mi := &file_confa_server_v1_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvitesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvitesRequest) ProtoMessage() {}
+
+func (x *ListInvitesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_server_v1_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvitesRequest.ProtoReflect.Descriptor instead.
+func (*ListInvitesRequest) Descriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListInvitesRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+type ListInvitesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invites       []*Invite              `protobuf:"bytes,1,rep,name=invites,proto3" json:"invites,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvitesResponse) Reset() {
+	*x = ListInvitesResponse{}
+	mi := &file_confa_server_v1_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvitesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvitesResponse) ProtoMessage() {}
+
+func (x *ListInvitesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_server_v1_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvitesResponse.ProtoReflect.Descriptor instead.
+func (*ListInvitesResponse) Descriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListInvitesResponse) GetInvites() []*Invite {
+	if x != nil {
+		return x.Invites
+	}
+	return nil
+}
+
+type RevokeInviteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeInviteRequest) Reset() {
+	*x = RevokeInviteRequest{}
+	mi := &file_confa_server_v1_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeInviteRequest) ProtoMessage() {}
+
+func (x *RevokeInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_server_v1_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeInviteRequest.ProtoReflect.Descriptor instead.
+func (*RevokeInviteRequest) Descriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RevokeInviteRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type RevokeInviteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeInviteResponse) Reset() {
+	*x = RevokeInviteResponse{}
+	mi := &file_confa_server_v1_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeInviteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeInviteResponse) ProtoMessage() {}
+
+func (x *RevokeInviteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_server_v1_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeInviteResponse.ProtoReflect.Descriptor instead.
+func (*RevokeInviteResponse) Descriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{23}
+}
+
+type RedeemInviteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RedeemInviteRequest) Reset() {
+	*x = RedeemInviteRequest{}
+	mi := &file_confa_server_v1_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedeemInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeemInviteRequest) ProtoMessage() {}
+
+func (x *RedeemInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_server_v1_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeemInviteRequest.ProtoReflect.Descriptor instead.
+func (*RedeemInviteRequest) Descriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RedeemInviteRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type RedeemInviteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invite        *Invite                `protobuf:"bytes,1,opt,name=invite,proto3" json:"invite,omitempty"`
+	Member        *ServerMember          `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RedeemInviteResponse) Reset() {
+	*x = RedeemInviteResponse{}
+	mi := &file_confa_server_v1_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedeemInviteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeemInviteResponse) ProtoMessage() {}
+
+func (x *RedeemInviteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_server_v1_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeemInviteResponse.ProtoReflect.Descriptor instead.
+func (*RedeemInviteResponse) Descriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RedeemInviteResponse) GetInvite() *Invite {
+	if x != nil {
+		return x.Invite
+	}
+	return nil
+}
+
+func (x *RedeemInviteResponse) GetMember() *ServerMember {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+type Server struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	OwnerId       string                 `protobuf:"bytes,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Server) Reset() {
+	*x = Server{}
+	mi := &file_confa_server_v1_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server) ProtoMessage() {}
+
+func (x *Server) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_server_v1_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server.ProtoReflect.Descriptor instead.
+func (*Server) Descriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *Server) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Server) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Server) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+type CreateServerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateServerRequest) Reset() {
+	*x = CreateServerRequest{}
+	mi := &file_confa_server_v1_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateServerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateServerRequest) ProtoMessage() {}
+
+func (x *CreateServerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_server_v1_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateServerRequest.ProtoReflect.Descriptor instead.
+func (*CreateServerRequest) Descriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CreateServerRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateServerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Server        *Server                `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateServerResponse) Reset() {
+	*x = CreateServerResponse{}
+	mi := &file_confa_server_v1_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateServerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateServerResponse) ProtoMessage() {}
+
+func (x *CreateServerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_server_v1_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateServerResponse.ProtoReflect.Descriptor instead.
+func (*CreateServerResponse) Descriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CreateServerResponse) GetServer() *Server {
+	if x != nil {
+		return x.Server
+	}
+	return nil
+}
+
+type GetServerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetServerRequest) Reset() {
+	*x = GetServerRequest{}
+	mi := &file_confa_server_v1_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetServerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServerRequest) ProtoMessage() {}
+
+func (x *GetServerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_server_v1_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServerRequest.ProtoReflect.Descriptor instead.
+func (*GetServerRequest) Descriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetServerRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+type GetServerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Server        *Server                `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetServerResponse) Reset() {
+	*x = GetServerResponse{}
+	mi := &file_confa_server_v1_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetServerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServerResponse) ProtoMessage() {}
+
+func (x *GetServerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_server_v1_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServerResponse.ProtoReflect.Descriptor instead.
+func (*GetServerResponse) Descriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetServerResponse) GetServer() *Server {
+	if x != nil {
+		return x.Server
+	}
+	return nil
+}
+
+type RenameServerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameServerRequest) Reset() {
+	*x = RenameServerRequest{}
+	mi := &file_confa_server_v1_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameServerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameServerRequest) ProtoMessage() {}
+
+func (x *RenameServerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_server_v1_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameServerRequest.ProtoReflect.Descriptor instead.
+func (*RenameServerRequest) Descriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RenameServerRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *RenameServerRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RenameServerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Server        *Server                `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameServerResponse) Reset() {
+	*x = RenameServerResponse{}
+	mi := &file_confa_server_v1_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameServerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameServerResponse) ProtoMessage() {}
+
+func (x *RenameServerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_server_v1_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameServerResponse.ProtoReflect.Descriptor instead.
+func (*RenameServerResponse) Descriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RenameServerResponse) GetServer() *Server {
+	if x != nil {
+		return x.Server
+	}
+	return nil
+}
+
+type DeleteServerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteServerRequest) Reset() {
+	*x = DeleteServerRequest{}
+	mi := &file_confa_server_v1_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteServerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteServerRequest) ProtoMessage() {}
+
+func (x *DeleteServerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_server_v1_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteServerRequest.ProtoReflect.Descriptor instead.
+func (*DeleteServerRequest) Descriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DeleteServerRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+type DeleteServerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteServerResponse) Reset() {
+	*x = DeleteServerResponse{}
+	mi := &file_confa_server_v1_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteServerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteServerResponse) ProtoMessage() {}
+
+func (x *DeleteServerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_server_v1_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteServerResponse.ProtoReflect.Descriptor instead.
+func (*DeleteServerResponse) Descriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{34}
+}
+
+type TransferServerOwnershipRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	NewOwnerId    string                 `protobuf:"bytes,2,opt,name=new_owner_id,json=newOwnerId,proto3" json:"new_owner_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferServerOwnershipRequest) Reset() {
+	*x = TransferServerOwnershipRequest{}
+	mi := &file_confa_server_v1_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferServerOwnershipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferServerOwnershipRequest) ProtoMessage() {}
+
+func (x *TransferServerOwnershipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_server_v1_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferServerOwnershipRequest.ProtoReflect.Descriptor instead.
+func (*TransferServerOwnershipRequest) Descriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *TransferServerOwnershipRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *TransferServerOwnershipRequest) GetNewOwnerId() string {
+	if x != nil {
+		return x.NewOwnerId
+	}
+	return ""
+}
+
+type TransferServerOwnershipResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Server        *Server                `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferServerOwnershipResponse) Reset() {
+	*x = TransferServerOwnershipResponse{}
+	mi := &file_confa_server_v1_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferServerOwnershipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferServerOwnershipResponse) ProtoMessage() {}
+
+func (x *TransferServerOwnershipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_server_v1_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferServerOwnershipResponse.ProtoReflect.Descriptor instead.
+func (*TransferServerOwnershipResponse) Descriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *TransferServerOwnershipResponse) GetServer() *Server {
+	if x != nil {
+		return x.Server
+	}
+	return nil
+}
+
+type Role struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ServerId      string                 `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Permissions   uint64                 `protobuf:"varint,4,opt,name=permissions,proto3" json:"permissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Role) Reset() {
+	*x = Role{}
+	mi := &file_confa_server_v1_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Role) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Role) ProtoMessage() {}
+
+func (x *Role) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_server_v1_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Role.ProtoReflect.Descriptor instead.
+func (*Role) Descriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *Role) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Role) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *Role) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Role) GetPermissions() uint64 {
+	if x != nil {
+		return x.Permissions
+	}
+	return 0
+}
+
+type ChannelOverwrite struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChannelId     string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	TargetType    OverwriteTarget        `protobuf:"varint,2,opt,name=target_type,json=targetType,proto3,enum=confa.server.v1.OverwriteTarget" json:"target_type,omitempty"`
+	TargetId      string                 `protobuf:"bytes,3,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	Allow         uint64                 `protobuf:"varint,4,opt,name=allow,proto3" json:"allow,omitempty"`
+	Deny          uint64                 `protobuf:"varint,5,opt,name=deny,proto3" json:"deny,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChannelOverwrite) Reset() {
+	*x = ChannelOverwrite{}
+	mi := &file_confa_server_v1_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelOverwrite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelOverwrite) ProtoMessage() {}
+
+func (x *ChannelOverwrite) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_server_v1_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelOverwrite.ProtoReflect.Descriptor instead.
+func (*ChannelOverwrite) Descriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ChannelOverwrite) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *ChannelOverwrite) GetTargetType() OverwriteTarget {
+	if x != nil {
+		return x.TargetType
+	}
+	return OverwriteTarget_OVERWRITE_TARGET_UNSPECIFIED
+}
+
+func (x *ChannelOverwrite) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *ChannelOverwrite) GetAllow() uint64 {
+	if x != nil {
+		return x.Allow
+	}
+	return 0
+}
+
+func (x *ChannelOverwrite) GetDeny() uint64 {
+	if x != nil {
+		return x.Deny
+	}
+	return 0
+}
+
+type CreateRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Permissions   uint64                 `protobuf:"varint,3,opt,name=permissions,proto3" json:"permissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRoleRequest) Reset() {
+	*x = CreateRoleRequest{}
+	mi := &file_confa_server_v1_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoleRequest) ProtoMessage() {}
+
+func (x *CreateRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_server_v1_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoleRequest.ProtoReflect.Descriptor instead.
+func (*CreateRoleRequest) Descriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CreateRoleRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *CreateRoleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateRoleRequest) GetPermissions() uint64 {
+	if x != nil {
+		return x.Permissions
+	}
+	return 0
+}
+
+type CreateRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          *Role                  `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRoleResponse) Reset() {
+	*x = CreateRoleResponse{}
+	mi := &file_confa_server_v1_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoleResponse) ProtoMessage() {}
+
+func (x *CreateRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_server_v1_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoleResponse.ProtoReflect.Descriptor instead.
+func (*CreateRoleResponse) Descriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CreateRoleResponse) GetRole() *Role {
+	if x != nil {
+		return x.Role
+	}
+	return nil
+}
+
+type ListRolesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRolesRequest) Reset() {
+	*x = ListRolesRequest{}
+	mi := &file_confa_server_v1_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRolesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRolesRequest) ProtoMessage() {}
+
+func (x *ListRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_server_v1_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRolesRequest.ProtoReflect.Descriptor instead.
+func (*ListRolesRequest) Descriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListRolesRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+type ListRolesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Roles         []*Role                `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRolesResponse) Reset() {
+	*x = ListRolesResponse{}
+	mi := &file_confa_server_v1_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRolesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRolesResponse) ProtoMessage() {}
+
+func (x *ListRolesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_server_v1_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRolesResponse.ProtoReflect.Descriptor instead.
+func (*ListRolesResponse) Descriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListRolesResponse) GetRoles() []*Role {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+type UpdateRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoleId        string                 `protobuf:"bytes,1,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Permissions   uint64                 `protobuf:"varint,3,opt,name=permissions,proto3" json:"permissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRoleRequest) Reset() {
+	*x = UpdateRoleRequest{}
+	mi := &file_confa_server_v1_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRoleRequest) ProtoMessage() {}
+
+func (x *UpdateRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_server_v1_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRoleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRoleRequest) Descriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *UpdateRoleRequest) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
+func (x *UpdateRoleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateRoleRequest) GetPermissions() uint64 {
+	if x != nil {
+		return x.Permissions
+	}
+	return 0
+}
+
+type UpdateRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          *Role                  `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRoleResponse) Reset() {
+	*x = UpdateRoleResponse{}
+	mi := &file_confa_server_v1_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRoleResponse) ProtoMessage() {}
+
+func (x *UpdateRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_server_v1_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRoleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateRoleResponse) Descriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *UpdateRoleResponse) GetRole() *Role {
+	if x != nil {
+		return x.Role
+	}
+	return nil
+}
+
+type DeleteRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoleId        string                 `protobuf:"bytes,1,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRoleRequest) Reset() {
+	*x = DeleteRoleRequest{}
+	mi := &file_confa_server_v1_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRoleRequest) ProtoMessage() {}
+
+func (x *DeleteRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_server_v1_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRoleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRoleRequest) Descriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *DeleteRoleRequest) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
+type DeleteRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRoleResponse) Reset() {
+	*x = DeleteRoleResponse{}
+	mi := &file_confa_server_v1_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRoleResponse) ProtoMessage() {}
+
+func (x *DeleteRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_server_v1_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRoleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRoleResponse) Descriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{46}
+}
+
+type AssignRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoleId        string                 `protobuf:"bytes,1,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignRoleRequest) Reset() {
+	*x = AssignRoleRequest{}
+	mi := &file_confa_server_v1_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignRoleRequest) ProtoMessage() {}
+
+func (x *AssignRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_server_v1_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignRoleRequest.ProtoReflect.Descriptor instead.
+func (*AssignRoleRequest) Descriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *AssignRoleRequest) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
+func (x *AssignRoleRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type AssignRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignRoleResponse) Reset() {
+	*x = AssignRoleResponse{}
+	mi := &file_confa_server_v1_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignRoleResponse) ProtoMessage() {}
+
+func (x *AssignRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_server_v1_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignRoleResponse.ProtoReflect.Descriptor instead.
+func (*AssignRoleResponse) Descriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{48}
+}
+
+type UnassignRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoleId        string                 `protobuf:"bytes,1,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnassignRoleRequest) Reset() {
+	*x = UnassignRoleRequest{}
+	mi := &file_confa_server_v1_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnassignRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnassignRoleRequest) ProtoMessage() {}
+
+func (x *UnassignRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_server_v1_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnassignRoleRequest.ProtoReflect.Descriptor instead.
+func (*UnassignRoleRequest) Descriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *UnassignRoleRequest) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
+func (x *UnassignRoleRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type UnassignRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnassignRoleResponse) Reset() {
+	*x = UnassignRoleResponse{}
+	mi := &file_confa_server_v1_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnassignRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnassignRoleResponse) ProtoMessage() {}
+
+func (x *UnassignRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_server_v1_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnassignRoleResponse.ProtoReflect.Descriptor instead.
+func (*UnassignRoleResponse) Descriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{50}
+}
+
+type SetChannelOverwriteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Overwrite     *ChannelOverwrite      `protobuf:"bytes,1,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetChannelOverwriteRequest) Reset() {
+	*x = SetChannelOverwriteRequest{}
+	mi := &file_confa_server_v1_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetChannelOverwriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetChannelOverwriteRequest) ProtoMessage() {}
+
+func (x *SetChannelOverwriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_server_v1_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetChannelOverwriteRequest.ProtoReflect.Descriptor instead.
+func (*SetChannelOverwriteRequest) Descriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *SetChannelOverwriteRequest) GetOverwrite() *ChannelOverwrite {
+	if x != nil {
+		return x.Overwrite
+	}
+	return nil
+}
+
+type SetChannelOverwriteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Overwrite     *ChannelOverwrite      `protobuf:"bytes,1,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetChannelOverwriteResponse) Reset() {
+	*x = SetChannelOverwriteResponse{}
+	mi := &file_confa_server_v1_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetChannelOverwriteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetChannelOverwriteResponse) ProtoMessage() {}
+
+func (x *SetChannelOverwriteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_server_v1_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetChannelOverwriteResponse.ProtoReflect.Descriptor instead.
+func (*SetChannelOverwriteResponse) Descriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *SetChannelOverwriteResponse) GetOverwrite() *ChannelOverwrite {
+	if x != nil {
+		return x.Overwrite
+	}
+	return nil
+}
+
+type DeleteChannelOverwriteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChannelId     string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	TargetId      string                 `protobuf:"bytes,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteChannelOverwriteRequest) Reset() {
+	*x = DeleteChannelOverwriteRequest{}
+	mi := &file_confa_server_v1_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteChannelOverwriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteChannelOverwriteRequest) ProtoMessage() {}
+
+func (x *DeleteChannelOverwriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_server_v1_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteChannelOverwriteRequest.ProtoReflect.Descriptor instead.
+func (*DeleteChannelOverwriteRequest) Descriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *DeleteChannelOverwriteRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *DeleteChannelOverwriteRequest) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+type DeleteChannelOverwriteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteChannelOverwriteResponse) Reset() {
+	*x = DeleteChannelOverwriteResponse{}
+	mi := &file_confa_server_v1_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteChannelOverwriteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteChannelOverwriteResponse) ProtoMessage() {}
+
+func (x *DeleteChannelOverwriteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_server_v1_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteChannelOverwriteResponse.ProtoReflect.Descriptor instead.
+func (*DeleteChannelOverwriteResponse) Descriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{54}
+}
+
+type ListChannelOverwritesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChannelId     string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChannelOverwritesRequest) Reset() {
+	*x = ListChannelOverwritesRequest{}
+	mi := &file_confa_server_v1_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChannelOverwritesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChannelOverwritesRequest) ProtoMessage() {}
+
+func (x *ListChannelOverwritesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_server_v1_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChannelOverwritesRequest.ProtoReflect.Descriptor instead.
+func (*ListChannelOverwritesRequest) Descriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ListChannelOverwritesRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+type ListChannelOverwritesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Overwrites    []*ChannelOverwrite    `protobuf:"bytes,1,rep,name=overwrites,proto3" json:"overwrites,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChannelOverwritesResponse) Reset() {
+	*x = ListChannelOverwritesResponse{}
+	mi := &file_confa_server_v1_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChannelOverwritesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChannelOverwritesResponse) ProtoMessage() {}
+
+func (x *ListChannelOverwritesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_server_v1_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChannelOverwritesResponse.ProtoReflect.Descriptor instead.
+func (*ListChannelOverwritesResponse) Descriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListChannelOverwritesResponse) GetOverwrites() []*ChannelOverwrite {
+	if x != nil {
+		return x.Overwrites
+	}
+	return nil
+}
+
+type GetPermissionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	ChannelId     string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPermissionsRequest) Reset() {
+	*x = GetPermissionsRequest{}
+	mi := &file_confa_server_v1_service_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPermissionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPermissionsRequest) ProtoMessage() {}
+
+func (x *GetPermissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_server_v1_service_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPermissionsRequest.ProtoReflect.Descriptor instead.
+func (*GetPermissionsRequest) Descriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetPermissionsRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *GetPermissionsRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+type GetPermissionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Permissions   uint64                 `protobuf:"varint,1,opt,name=permissions,proto3" json:"permissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPermissionsResponse) Reset() {
+	*x = GetPermissionsResponse{}
+	mi := &file_confa_server_v1_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPermissionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPermissionsResponse) ProtoMessage() {}
+
+func (x *GetPermissionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_server_v1_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPermissionsResponse.ProtoReflect.Descriptor instead.
+func (*GetPermissionsResponse) Descriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetPermissionsResponse) GetPermissions() uint64 {
+	if x != nil {
+		return x.Permissions
+	}
+	return 0
+}
+
+type Ban struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ModeratorId   string                 `protobuf:"bytes,3,opt,name=moderator_id,json=moderatorId,proto3" json:"moderator_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Ban) Reset() {
+	*x = Ban{}
+	mi := &file_confa_server_v1_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Ban) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ban) ProtoMessage() {}
+
+func (x *Ban) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_server_v1_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ban.ProtoReflect.Descriptor instead.
+func (*Ban) Descriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *Ban) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *Ban) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Ban) GetModeratorId() string {
+	if x != nil {
+		return x.ModeratorId
+	}
+	return ""
+}
+
+func (x *Ban) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Ban) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Ban) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type Mute struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ModeratorId   string                 `protobuf:"bytes,3,opt,name=moderator_id,json=moderatorId,proto3" json:"moderator_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Mute) Reset() {
+	*x = Mute{}
+	mi := &file_confa_server_v1_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Mute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Mute) ProtoMessage() {}
+
+func (x *Mute) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_server_v1_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Mute.ProtoReflect.Descriptor instead.
+func (*Mute) Descriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *Mute) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *Mute) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Mute) GetModeratorId() string {
+	if x != nil {
+		return x.ModeratorId
+	}
+	return ""
+}
+
+func (x *Mute) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Mute) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Mute) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type KickMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KickMemberRequest) Reset() {
+	*x = KickMemberRequest{}
+	mi := &file_confa_server_v1_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KickMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickMemberRequest) ProtoMessage() {}
+
+func (x *KickMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_server_v1_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickMemberRequest.ProtoReflect.Descriptor instead.
+func (*KickMemberRequest) Descriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *KickMemberRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *KickMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type KickMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KickMemberResponse) Reset() {
+	*x = KickMemberResponse{}
+	mi := &file_confa_server_v1_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KickMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickMemberResponse) ProtoMessage() {}
+
+func (x *KickMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_server_v1_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickMemberResponse.ProtoReflect.Descriptor instead.
+func (*KickMemberResponse) Descriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{62}
+}
+
+type BanMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Duration      *durationpb.Duration   `protobuf:"bytes,4,opt,name=duration,proto3" json:"duration,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BanMemberRequest) Reset() {
+	*x = BanMemberRequest{}
+	mi := &file_confa_server_v1_service_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BanMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BanMemberRequest) ProtoMessage() {}
+
+func (x *BanMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_server_v1_service_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BanMemberRequest.ProtoReflect.Descriptor instead.
+func (*BanMemberRequest) Descriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *BanMemberRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *BanMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *BanMemberRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BanMemberRequest) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+type BanMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ban           *Ban                   `protobuf:"bytes,1,opt,name=ban,proto3" json:"ban,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BanMemberResponse) Reset() {
+	*x = BanMemberResponse{}
+	mi := &file_confa_server_v1_service_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BanMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BanMemberResponse) ProtoMessage() {}
+
+func (x *BanMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_server_v1_service_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BanMemberResponse.ProtoReflect.Descriptor instead.
+func (*BanMemberResponse) Descriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *BanMemberResponse) GetBan() *Ban {
+	if x != nil {
+		return x.Ban
+	}
+	return nil
+}
+
+type UnbanMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnbanMemberRequest) Reset() {
+	*x = UnbanMemberRequest{}
+	mi := &file_confa_server_v1_service_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnbanMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnbanMemberRequest) ProtoMessage() {}
+
+func (x *UnbanMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_server_v1_service_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnbanMemberRequest.ProtoReflect.Descriptor instead.
+func (*UnbanMemberRequest) Descriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *UnbanMemberRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *UnbanMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type UnbanMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnbanMemberResponse) Reset() {
+	*x = UnbanMemberResponse{}
+	mi := &file_confa_server_v1_service_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnbanMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnbanMemberResponse) ProtoMessage() {}
+
+func (x *UnbanMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_server_v1_service_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnbanMemberResponse.ProtoReflect.Descriptor instead.
+func (*UnbanMemberResponse) Descriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{66}
+}
+
+type ListBansRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBansRequest) Reset() {
+	*x = ListBansRequest{}
+	mi := &file_confa_server_v1_service_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBansRequest) ProtoMessage() {}
+
+func (x *ListBansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_server_v1_service_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBansRequest.ProtoReflect.Descriptor instead.
+func (*ListBansRequest) Descriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ListBansRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+type ListBansResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bans          []*Ban                 `protobuf:"bytes,1,rep,name=bans,proto3" json:"bans,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBansResponse) Reset() {
+	*x = ListBansResponse{}
+	mi := &file_confa_server_v1_service_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBansResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBansResponse) ProtoMessage() {}
+
+func (x *ListBansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_server_v1_service_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBansResponse.ProtoReflect.Descriptor instead.
+func (*ListBansResponse) Descriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ListBansResponse) GetBans() []*Ban {
+	if x != nil {
+		return x.Bans
+	}
+	return nil
+}
+
+type MuteMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Duration      *durationpb.Duration   `protobuf:"bytes,4,opt,name=duration,proto3" json:"duration,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MuteMemberRequest) Reset() {
+	*x = MuteMemberRequest{}
+	mi := &file_confa_server_v1_service_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MuteMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuteMemberRequest) ProtoMessage() {}
+
+func (x *MuteMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_server_v1_service_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuteMemberRequest.ProtoReflect.Descriptor instead.
+func (*MuteMemberRequest) Descriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *MuteMemberRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *MuteMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *MuteMemberRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *MuteMemberRequest) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+type MuteMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mute          *Mute                  `protobuf:"bytes,1,opt,name=mute,proto3" json:"mute,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MuteMemberResponse) Reset() {
+	*x = MuteMemberResponse{}
+	mi := &file_confa_server_v1_service_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MuteMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuteMemberResponse) ProtoMessage() {}
+
+func (x *MuteMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_server_v1_service_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuteMemberResponse.ProtoReflect.Descriptor instead.
+func (*MuteMemberResponse) Descriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *MuteMemberResponse) GetMute() *Mute {
+	if x != nil {
+		return x.Mute
+	}
+	return nil
+}
+
+type UnmuteMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnmuteMemberRequest) Reset() {
+	*x = UnmuteMemberRequest{}
+	mi := &file_confa_server_v1_service_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnmuteMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnmuteMemberRequest) ProtoMessage() {}
+
+func (x *UnmuteMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_server_v1_service_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnmuteMemberRequest.ProtoReflect.Descriptor instead.
+func (*UnmuteMemberRequest) Descriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *UnmuteMemberRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *UnmuteMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type UnmuteMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnmuteMemberResponse) Reset() {
+	*x = UnmuteMemberResponse{}
+	mi := &file_confa_server_v1_service_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnmuteMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnmuteMemberResponse) ProtoMessage() {}
+
+func (x *UnmuteMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_confa_server_v1_service_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnmuteMemberResponse.ProtoReflect.Descriptor instead.
+func (*UnmuteMemberResponse) Descriptor() ([]byte, []int) {
+	return file_confa_server_v1_service_proto_rawDescGZIP(), []int{72}
+}
+
+var File_confa_server_v1_service_proto protoreflect.FileDescriptor
+
+const file_confa_server_v1_service_proto_rawDesc = "" +
+	"\n" +
+	"\x1dconfa/server/v1/service.proto\x12\x0fconfa.server.v1\x1a\x18confa/user/v1/user.proto\x1a\x1fconfa/channel/v1/channels.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"2\n" +
+	"\x13ListChannelsRequest\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\"M\n" +
+	"\x14ListChannelsResponse\x125\n" +
+	"\bchannels\x18\x01 \x03(\v2\x19.confa.channel.v1.ChannelR\bchannels\"/\n" +
+	"\x10ListUsersRequest\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\"w\n" +
+	"\x11ListUsersResponse\x12)\n" +
+	"\x05users\x18\x01 \x03(\v2\x13.confa.user.v1.UserR\x05users\x127\n" +
+	"\amembers\x18\x02 \x03(\v2\x1d.confa.server.v1.ServerMemberR\amembers\"\xa7\x01\n" +
+	"\fServerMember\x12'\n" +
+	"\x04user\x18\x01 \x01(\v2\x13.confa.user.v1.UserR\x04user\x12\x1a\n" +
+	"\bnickname\x18\x02 \x01(\tR\bnickname\x127\n" +
+	"\tjoined_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bjoinedAt\x12\x19\n" +
+	"\brole_ids\x18\x04 \x03(\tR\aroleIds\"\xb2\x01\n" +
+	"\x14CreateChannelRequest\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12E\n" +
+	"\x04type\x18\x03 \x01(\x0e21.confa.server.v1.CreateChannelRequest.ChannelTypeR\x04type\"\"\n" +
+	"\vChannelType\x12\b\n" +
+	"\x04TEXT\x10\x00\x12\t\n" +
+	"\x05VOICE\x10\x01\"L\n" +
+	"\x15CreateChannelResponse\x123\n" +
+	"\achannel\x18\x01 \x01(\v2\x19.confa.channel.v1.ChannelR\achannel\"\xcd\x01\n" +
+	"\x12EditChannelRequest\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x02 \x01(\tR\tchannelId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12C\n" +
+	"\x04type\x18\x04 \x01(\x0e2/.confa.server.v1.EditChannelRequest.ChannelTypeR\x04type\"\"\n" +
+	"\vChannelType\x12\b\n" +
+	"\x04TEXT\x10\x00\x12\t\n" +
+	"\x05VOICE\x10\x01\"J\n" +
+	"\x13EditChannelResponse\x123\n" +
+	"\achannel\x18\x01 \x01(\v2\x19.confa.channel.v1.ChannelR\achannel\"\x95\x01\n" +
+	"\x1bSetChannelMessageTTLRequest\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x02 \x01(\tR\tchannelId\x12:\n" +
+	"\vmessage_ttl\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"messageTtl\"S\n" +
+	"\x1cSetChannelMessageTTLResponse\x123\n" +
+	"\achannel\x18\x01 \x01(\v2\x19.confa.channel.v1.ChannelR\achannel\"R\n" +
+	"\x14ExportChannelRequest\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x02 \x01(\tR\tchannelId\"-\n" +
+	"\x15ExportChannelResponse\x12\x14\n" +
+	"\x05chunk\x18\x01 \x01(\fR\x05chunk\"1\n" +
+	"\x12LeaveServerRequest\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\"\x15\n" +
+	"\x13LeaveServerResponse\"M\n" +
+	"\x12SetNicknameRequest\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12\x1a\n" +
+	"\bnickname\x18\x02 \x01(\tR\bnickname\"L\n" +
+	"\x13SetNicknameResponse\x125\n" +
+	"\x06member\x18\x01 \x01(\v2\x1d.confa.server.v1.ServerMemberR\x06member\"\x9c\x02\n" +
+	"\x06Invite\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x1b\n" +
+	"\tserver_id\x18\x02 \x01(\tR\bserverId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x03 \x01(\tR\tchannelId\x12\x1d\n" +
+	"\n" +
+	"creator_id\x18\x04 \x01(\tR\tcreatorId\x12\x19\n" +
+	"\bmax_uses\x18\x05 \x01(\x05R\amaxUses\x12\x12\n" +
+	"\x04uses\x18\x06 \x01(\x05R\x04uses\x129\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xa6\x01\n" +
+	"\x13CreateInviteRequest\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x02 \x01(\tR\tchannelId\x12\x19\n" +
+	"\bmax_uses\x18\x03 \x01(\x05R\amaxUses\x128\n" +
+	"\n" +
+	"expires_in\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\texpiresIn\"G\n" +
+	"\x14CreateInviteResponse\x12/\n" +
+	"\x06invite\x18\x01 \x01(\v2\x17.confa.server.v1.InviteR\x06invite\"1\n" +
+	"\x12ListInvitesRequest\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\"H\n" +
+	"\x13ListInvitesResponse\x121\n" +
+	"\ainvites\x18\x01 \x03(\v2\x17.confa.server.v1.InviteR\ainvites\")\n" +
+	"\x13RevokeInviteRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"\x16\n" +
+	"\x14RevokeInviteResponse\")\n" +
+	"\x13RedeemInviteRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"~\n" +
+	"\x14RedeemInviteResponse\x12/\n" +
+	"\x06invite\x18\x01 \x01(\v2\x17.confa.server.v1.InviteR\x06invite\x125\n" +
+	"\x06member\x18\x02 \x01(\v2\x1d.confa.server.v1.ServerMemberR\x06member\"G\n" +
+	"\x06Server\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x19\n" +
+	"\bowner_id\x18\x03 \x01(\tR\aownerId\")\n" +
+	"\x13CreateServerRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"G\n" +
+	"\x14CreateServerResponse\x12/\n" +
+	"\x06server\x18\x01 \x01(\v2\x17.confa.server.v1.ServerR\x06server\"/\n" +
+	"\x10GetServerRequest\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\"D\n" +
+	"\x11GetServerResponse\x12/\n" +
+	"\x06server\x18\x01 \x01(\v2\x17.confa.server.v1.ServerR\x06server\"F\n" +
+	"\x13RenameServerRequest\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"G\n" +
+	"\x14RenameServerResponse\x12/\n" +
+	"\x06server\x18\x01 \x01(\v2\x17.confa.server.v1.ServerR\x06server\"2\n" +
+	"\x13DeleteServerRequest\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\"\x16\n" +
+	"\x14DeleteServerResponse\"_\n" +
+	"\x1eTransferServerOwnershipRequest\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12 \n" +
+	"\fnew_owner_id\x18\x02 \x01(\tR\n" +
+	"newOwnerId\"R\n" +
+	"\x1fTransferServerOwnershipResponse\x12/\n" +
+	"\x06server\x18\x01 \x01(\v2\x17.confa.server.v1.ServerR\x06server\"i\n" +
+	"\x04Role\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tserver_id\x18\x02 \x01(\tR\bserverId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vpermissions\x18\x04 \x01(\x04R\vpermissions\"\xbb\x01\n" +
+	"\x10ChannelOverwrite\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x01 \x01(\tR\tchannelId\x12A\n" +
+	"\vtarget_type\x18\x02 \x01(\x0e2 .confa.server.v1.OverwriteTargetR\n" +
+	"targetType\x12\x1b\n" +
+	"\ttarget_id\x18\x03 \x01(\tR\btargetId\x12\x14\n" +
+	"\x05allow\x18\x04 \x01(\x04R\x05allow\x12\x12\n" +
+	"\x04deny\x18\x05 \x01(\x04R\x04deny\"f\n" +
+	"\x11CreateRoleRequest\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vpermissions\x18\x03 \x01(\x04R\vpermissions\"?\n" +
+	"\x12CreateRoleResponse\x12)\n" +
+	"\x04role\x18\x01 \x01(\v2\x15.confa.server.v1.RoleR\x04role\"/\n" +
+	"\x10ListRolesRequest\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\"@\n" +
+	"\x11ListRolesResponse\x12+\n" +
+	"\x05roles\x18\x01 \x03(\v2\x15.confa.server.v1.RoleR\x05roles\"b\n" +
+	"\x11UpdateRoleRequest\x12\x17\n" +
+	"\arole_id\x18\x01 \x01(\tR\x06roleId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vpermissions\x18\x03 \x01(\x04R\vpermissions\"?\n" +
+	"\x12UpdateRoleResponse\x12)\n" +
+	"\x04role\x18\x01 \x01(\v2\x15.confa.server.v1.RoleR\x04role\",\n" +
+	"\x11DeleteRoleRequest\x12\x17\n" +
+	"\arole_id\x18\x01 \x01(\tR\x06roleId\"\x14\n" +
+	"\x12DeleteRoleResponse\"E\n" +
+	"\x11AssignRoleRequest\x12\x17\n" +
+	"\arole_id\x18\x01 \x01(\tR\x06roleId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x14\n" +
+	"\x12AssignRoleResponse\"G\n" +
+	"\x13UnassignRoleRequest\x12\x17\n" +
+	"\arole_id\x18\x01 \x01(\tR\x06roleId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x16\n" +
+	"\x14UnassignRoleResponse\"]\n" +
+	"\x1aSetChannelOverwriteRequest\x12?\n" +
+	"\toverwrite\x18\x01 \x01(\v2!.confa.server.v1.ChannelOverwriteR\toverwrite\"^\n" +
+	"\x1bSetChannelOverwriteResponse\x12?\n" +
+	"\toverwrite\x18\x01 \x01(\v2!.confa.server.v1.ChannelOverwriteR\toverwrite\"[\n" +
+	"\x1dDeleteChannelOverwriteRequest\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x01 \x01(\tR\tchannelId\x12\x1b\n" +
+	"\ttarget_id\x18\x02 \x01(\tR\btargetId\" \n" +
+	"\x1eDeleteChannelOverwriteResponse\"=\n" +
+	"\x1cListChannelOverwritesRequest\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x01 \x01(\tR\tchannelId\"b\n" +
+	"\x1dListChannelOverwritesResponse\x12A\n" +
+	"\n" +
+	"overwrites\x18\x01 \x03(\v2!.confa.server.v1.ChannelOverwriteR\n" +
+	"overwrites\"S\n" +
+	"\x15GetPermissionsRequest\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x02 \x01(\tR\tchannelId\":\n" +
+	"\x16GetPermissionsResponse\x12 \n" +
+	"\vpermissions\x18\x01 \x01(\x04R\vpermissions\"\xec\x01\n" +
+	"\x03Ban\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12!\n" +
+	"\fmoderator_id\x18\x03 \x01(\tR\vmoderatorId\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x129\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xed\x01\n" +
+	"\x04Mute\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12!\n" +
+	"\fmoderator_id\x18\x03 \x01(\tR\vmoderatorId\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x129\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"I\n" +
+	"\x11KickMemberRequest\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x14\n" +
+	"\x12KickMemberResponse\"\x97\x01\n" +
+	"\x10BanMemberRequest\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x125\n" +
+	"\bduration\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\bduration\";\n" +
+	"\x11BanMemberResponse\x12&\n" +
+	"\x03ban\x18\x01 \x01(\v2\x14.confa.server.v1.BanR\x03ban\"J\n" +
+	"\x12UnbanMemberRequest\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x15\n" +
+	"\x13UnbanMemberResponse\".\n" +
+	"\x0fListBansRequest\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\"<\n" +
+	"\x10ListBansResponse\x12(\n" +
+	"\x04bans\x18\x01 \x03(\v2\x14.confa.server.v1.BanR\x04bans\"\x98\x01\n" +
+	"\x11MuteMemberRequest\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x125\n" +
+	"\bduration\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\bduration\"?\n" +
+	"\x12MuteMemberResponse\x12)\n" +
+	"\x04mute\x18\x01 \x01(\v2\x15.confa.server.v1.MuteR\x04mute\"K\n" +
+	"\x13UnmuteMemberRequest\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x16\n" +
+	"\x14UnmuteMemberResponse*\x94\x03\n" +
+	"\n" +
+	"Permission\x12\x1a\n" +
+	"\x16PERMISSION_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17PERMISSION_VIEW_CHANNEL\x10\x01\x12\x1c\n" +
+	"\x18PERMISSION_SEND_MESSAGES\x10\x02\x12\x1c\n" +
+	"\x18PERMISSION_ADD_REACTIONS\x10\x04\x12\x1d\n" +
+	"\x19PERMISSION_CREATE_INVITES\x10\b\x12\x1e\n" +
+	"\x1aPERMISSION_MANAGE_MESSAGES\x10\x10\x12\x1e\n" +
+	"\x1aPERMISSION_MANAGE_CHANNELS\x10 \x12\x1b\n" +
+	"\x17PERMISSION_MANAGE_ROLES\x10@\x12\x1d\n" +
+	"\x18PERMISSION_MANAGE_SERVER\x10\x80\x01\x12\x1c\n" +
+	"\x17PERMISSION_KICK_MEMBERS\x10\x80\x02\x12\x1b\n" +
+	"\x16PERMISSION_BAN_MEMBERS\x10\x80\x04\x12\x1c\n" +
+	"\x17PERMISSION_MUTE_MEMBERS\x10\x80\b\x12\x1d\n" +
+	"\x18PERMISSION_ADMINISTRATOR\x10\x80\x10*k\n" +
+	"\x0fOverwriteTarget\x12 \n" +
+	"\x1cOVERWRITE_TARGET_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15OVERWRITE_TARGET_ROLE\x10\x01\x12\x1b\n" +
+	"\x17OVERWRITE_TARGET_MEMBER\x10\x022\x80\x19\n" +
+	"\rServerService\x12]\n" +
+	"\fListChannels\x12$.confa.server.v1.ListChannelsRequest\x1a%.confa.server.v1.ListChannelsResponse\"\x00\x12T\n" +
+	"\tListUsers\x12!.confa.server.v1.ListUsersRequest\x1a\".confa.server.v1.ListUsersResponse\"\x00\x12`\n" +
+	"\rCreateChannel\x12%.confa.server.v1.CreateChannelRequest\x1a&.confa.server.v1.CreateChannelResponse\"\x00\x12Z\n" +
+	"\vEditChannel\x12#.confa.server.v1.EditChannelRequest\x1a$.confa.server.v1.EditChannelResponse\"\x00\x12u\n" +
+	"\x14SetChannelMessageTTL\x12,.confa.server.v1.SetChannelMessageTTLRequest\x1a-.confa.server.v1.SetChannelMessageTTLResponse\"\x00\x12b\n" +
+	"\rExportChannel\x12%.confa.server.v1.ExportChannelRequest\x1a&.confa.server.v1.ExportChannelResponse\"\x000\x01\x12Z\n" +
+	"\vLeaveServer\x12#.confa.server.v1.LeaveServerRequest\x1a$.confa.server.v1.LeaveServerResponse\"\x00\x12Z\n" +
+	"\vSetNickname\x12#.confa.server.v1.SetNicknameRequest\x1a$.confa.server.v1.SetNicknameResponse\"\x00\x12]\n" +
+	"\fCreateInvite\x12$.confa.server.v1.CreateInviteRequest\x1a%.confa.server.v1.CreateInviteResponse\"\x00\x12Z\n" +
+	"\vListInvites\x12#.confa.server.v1.ListInvitesRequest\x1a$.confa.server.v1.ListInvitesResponse\"\x00\x12]\n" +
+	"\fRevokeInvite\x12$.confa.server.v1.RevokeInviteRequest\x1a%.confa.server.v1.RevokeInviteResponse\"\x00\x12]\n" +
+	"\fRedeemInvite\x12$.confa.server.v1.RedeemInviteRequest\x1a%.confa.server.v1.RedeemInviteResponse\"\x00\x12]\n" +
+	"\fCreateServer\x12$.confa.server.v1.CreateServerRequest\x1a%.confa.server.v1.CreateServerResponse\"\x00\x12T\n" +
+	"\tGetServer\x12!.confa.server.v1.GetServerRequest\x1a\".confa.server.v1.GetServerResponse\"\x00\x12]\n" +
+	"\fRenameServer\x12$.confa.server.v1.RenameServerRequest\x1a%.confa.server.v1.RenameServerResponse\"\x00\x12]\n" +
+	"\fDeleteServer\x12$.confa.server.v1.DeleteServerRequest\x1a%.confa.server.v1.DeleteServerResponse\"\x00\x12~\n" +
+	"\x17TransferServerOwnership\x12/.confa.server.v1.TransferServerOwnershipRequest\x1a0.confa.server.v1.TransferServerOwnershipResponse\"\x00\x12W\n" +
+	"\n" +
+	"CreateRole\x12\".confa.server.v1.CreateRoleRequest\x1a#.confa.server.v1.CreateRoleResponse\"\x00\x12T\n" +
+	"\tListRoles\x12!.confa.server.v1.ListRolesRequest\x1a\".confa.server.v1.ListRolesResponse\"\x00\x12W\n" +
+	"\n" +
+	"UpdateRole\x12\".confa.server.v1.UpdateRoleRequest\x1a#.confa.server.v1.UpdateRoleResponse\"\x00\x12W\n" +
+	"\n" +
+	"DeleteRole\x12\".confa.server.v1.DeleteRoleRequest\x1a#.confa.server.v1.DeleteRoleResponse\"\x00\x12W\n" +
+	"\n" +
+	"AssignRole\x12\".confa.server.v1.AssignRoleRequest\x1a#.confa.server.v1.AssignRoleResponse\"\x00\x12]\n" +
+	"\fUnassignRole\x12$.confa.server.v1.UnassignRoleRequest\x1a%.confa.server.v1.UnassignRoleResponse\"\x00\x12r\n" +
+	"\x13SetChannelOverwrite\x12+.confa.server.v1.SetChannelOverwriteRequest\x1a,.confa.server.v1.SetChannelOverwriteResponse\"\x00\x12{\n" +
+	"\x16DeleteChannelOverwrite\x12..confa.server.v1.DeleteChannelOverwriteRequest\x1a/.confa.server.v1.DeleteChannelOverwriteResponse\"\x00\x12x\n" +
+	"\x15ListChannelOverwrites\x12-.confa.server.v1.ListChannelOverwritesRequest\x1a..confa.server.v1.ListChannelOverwritesResponse\"\x00\x12c\n" +
+	"\x0eGetPermissions\x12&.confa.server.v1.GetPermissionsRequest\x1a'.confa.server.v1.GetPermissionsResponse\"\x00\x12W\n" +
+	"\n" +
+	"KickMember\x12\".confa.server.v1.KickMemberRequest\x1a#.confa.server.v1.KickMemberResponse\"\x00\x12T\n" +
+	"\tBanMember\x12!.confa.server.v1.BanMemberRequest\x1a\".confa.server.v1.BanMemberResponse\"\x00\x12Z\n" +
+	"\vUnbanMember\x12#.confa.server.v1.UnbanMemberRequest\x1a$.confa.server.v1.UnbanMemberResponse\"\x00\x12Q\n" +
+	"\bListBans\x12 .confa.server.v1.ListBansRequest\x1a!.confa.server.v1.ListBansResponse\"\x00\x12W\n" +
+	"\n" +
+	"MuteMember\x12\".confa.server.v1.MuteMemberRequest\x1a#.confa.server.v1.MuteMemberResponse\"\x00\x12]\n" +
+	"\fUnmuteMember\x12$.confa.server.v1.UnmuteMemberRequest\x1a%.confa.server.v1.UnmuteMemberResponse\"\x00B\xc0\x01\n" +
 	"\x13com.confa.server.v1B\fServiceProtoP\x01Z=github.com/confa-chat/node/src/proto/confa/server/v1;serverv1\xa2\x02\x03CSX\xaa\x02\x0fConfa.Server.V1\xca\x02\x0fConfa\\Server\\V1\xe2\x02\x1bConfa\\Server\\V1\\GPBMetadata\xea\x02\x11Confa::Server::V1b\x06proto3"
 
 var (
@@ -559,42 +4177,202 @@ func file_confa_server_v1_service_proto_rawDescGZIP() []byte {
 	return file_confa_server_v1_service_proto_rawDescData
 }
 
-var file_confa_server_v1_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_confa_server_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_confa_server_v1_service_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_confa_server_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 73)
 var file_confa_server_v1_service_proto_goTypes = []any{
-	(CreateChannelRequest_ChannelType)(0), // 0: confa.server.v1.CreateChannelRequest.ChannelType
-	(EditChannelRequest_ChannelType)(0),   // 1: confa.server.v1.EditChannelRequest.ChannelType
-	(*ListChannelsRequest)(nil),           // 2: confa.server.v1.ListChannelsRequest
-	(*ListChannelsResponse)(nil),          // 3: confa.server.v1.ListChannelsResponse
-	(*ListUsersRequest)(nil),              // 4: confa.server.v1.ListUsersRequest
-	(*ListUsersResponse)(nil),             // 5: confa.server.v1.ListUsersResponse
-	(*CreateChannelRequest)(nil),          // 6: confa.server.v1.CreateChannelRequest
-	(*CreateChannelResponse)(nil),         // 7: confa.server.v1.CreateChannelResponse
-	(*EditChannelRequest)(nil),            // 8: confa.server.v1.EditChannelRequest
-	(*EditChannelResponse)(nil),           // 9: confa.server.v1.EditChannelResponse
-	(*v1.Channel)(nil),                    // 10: confa.channel.v1.Channel
-	(*v11.User)(nil),                      // 11: confa.user.v1.User
+	(Permission)(0),                         // 0: confa.server.v1.Permission
+	(OverwriteTarget)(0),                    // 1: confa.server.v1.OverwriteTarget
+	(CreateChannelRequest_ChannelType)(0),   // 2: confa.server.v1.CreateChannelRequest.ChannelType
+	(EditChannelRequest_ChannelType)(0),     // 3: confa.server.v1.EditChannelRequest.ChannelType
+	(*ListChannelsRequest)(nil),             // 4: confa.server.v1.ListChannelsRequest
+	(*ListChannelsResponse)(nil),            // 5: confa.server.v1.ListChannelsResponse
+	(*ListUsersRequest)(nil),                // 6: confa.server.v1.ListUsersRequest
+	(*ListUsersResponse)(nil),               // 7: confa.server.v1.ListUsersResponse
+	(*ServerMember)(nil),                    // 8: confa.server.v1.ServerMember
+	(*CreateChannelRequest)(nil),            // 9: confa.server.v1.CreateChannelRequest
+	(*CreateChannelResponse)(nil),           // 10: confa.server.v1.CreateChannelResponse
+	(*EditChannelRequest)(nil),              // 11: confa.server.v1.EditChannelRequest
+	(*EditChannelResponse)(nil),             // 12: confa.server.v1.EditChannelResponse
+	(*SetChannelMessageTTLRequest)(nil),     // 13: confa.server.v1.SetChannelMessageTTLRequest
+	(*SetChannelMessageTTLResponse)(nil),    // 14: confa.server.v1.SetChannelMessageTTLResponse
+	(*ExportChannelRequest)(nil),            // 15: confa.server.v1.ExportChannelRequest
+	(*ExportChannelResponse)(nil),           // 16: confa.server.v1.ExportChannelResponse
+	(*LeaveServerRequest)(nil),              // 17: confa.server.v1.LeaveServerRequest
+	(*LeaveServerResponse)(nil),             // 18: confa.server.v1.LeaveServerResponse
+	(*SetNicknameRequest)(nil),              // 19: confa.server.v1.SetNicknameRequest
+	(*SetNicknameResponse)(nil),             // 20: confa.server.v1.SetNicknameResponse
+	(*Invite)(nil),                          // 21: confa.server.v1.Invite
+	(*CreateInviteRequest)(nil),             // 22: confa.server.v1.CreateInviteRequest
+	(*CreateInviteResponse)(nil),            // 23: confa.server.v1.CreateInviteResponse
+	(*ListInvitesRequest)(nil),              // 24: confa.server.v1.ListInvitesRequest
+	(*ListInvitesResponse)(nil),             // 25: confa.server.v1.ListInvitesResponse
+	(*RevokeInviteRequest)(nil),             // 26: confa.server.v1.RevokeInviteRequest
+	(*RevokeInviteResponse)(nil),            // 27: confa.server.v1.RevokeInviteResponse
+	(*RedeemInviteRequest)(nil),             // 28: confa.server.v1.RedeemInviteRequest
+	(*RedeemInviteResponse)(nil),            // 29: confa.server.v1.RedeemInviteResponse
+	(*Server)(nil),                          // 30: confa.server.v1.Server
+	(*CreateServerRequest)(nil),             // 31: confa.server.v1.CreateServerRequest
+	(*CreateServerResponse)(nil),            // 32: confa.server.v1.CreateServerResponse
+	(*GetServerRequest)(nil),                // 33: confa.server.v1.GetServerRequest
+	(*GetServerResponse)(nil),               // 34: confa.server.v1.GetServerResponse
+	(*RenameServerRequest)(nil),             // 35: confa.server.v1.RenameServerRequest
+	(*RenameServerResponse)(nil),            // 36: confa.server.v1.RenameServerResponse
+	(*DeleteServerRequest)(nil),             // 37: confa.server.v1.DeleteServerRequest
+	(*DeleteServerResponse)(nil),            // 38: confa.server.v1.DeleteServerResponse
+	(*TransferServerOwnershipRequest)(nil),  // 39: confa.server.v1.TransferServerOwnershipRequest
+	(*TransferServerOwnershipResponse)(nil), // 40: confa.server.v1.TransferServerOwnershipResponse
+	(*Role)(nil),                            // 41: confa.server.v1.Role
+	(*ChannelOverwrite)(nil),                // 42: confa.server.v1.ChannelOverwrite
+	(*CreateRoleRequest)(nil),               // 43: confa.server.v1.CreateRoleRequest
+	(*CreateRoleResponse)(nil),              // 44: confa.server.v1.CreateRoleResponse
+	(*ListRolesRequest)(nil),                // 45: confa.server.v1.ListRolesRequest
+	(*ListRolesResponse)(nil),               // 46: confa.server.v1.ListRolesResponse
+	(*UpdateRoleRequest)(nil),               // 47: confa.server.v1.UpdateRoleRequest
+	(*UpdateRoleResponse)(nil),              // 48: confa.server.v1.UpdateRoleResponse
+	(*DeleteRoleRequest)(nil),               // 49: confa.server.v1.DeleteRoleRequest
+	(*DeleteRoleResponse)(nil),              // 50: confa.server.v1.DeleteRoleResponse
+	(*AssignRoleRequest)(nil),               // 51: confa.server.v1.AssignRoleRequest
+	(*AssignRoleResponse)(nil),              // 52: confa.server.v1.AssignRoleResponse
+	(*UnassignRoleRequest)(nil),             // 53: confa.server.v1.UnassignRoleRequest
+	(*UnassignRoleResponse)(nil),            // 54: confa.server.v1.UnassignRoleResponse
+	(*SetChannelOverwriteRequest)(nil),      // 55: confa.server.v1.SetChannelOverwriteRequest
+	(*SetChannelOverwriteResponse)(nil),     // 56: confa.server.v1.SetChannelOverwriteResponse
+	(*DeleteChannelOverwriteRequest)(nil),   // 57: confa.server.v1.DeleteChannelOverwriteRequest
+	(*DeleteChannelOverwriteResponse)(nil),  // 58: confa.server.v1.DeleteChannelOverwriteResponse
+	(*ListChannelOverwritesRequest)(nil),    // 59: confa.server.v1.ListChannelOverwritesRequest
+	(*ListChannelOverwritesResponse)(nil),   // 60: confa.server.v1.ListChannelOverwritesResponse
+	(*GetPermissionsRequest)(nil),           // 61: confa.server.v1.GetPermissionsRequest
+	(*GetPermissionsResponse)(nil),          // 62: confa.server.v1.GetPermissionsResponse
+	(*Ban)(nil),                             // 63: confa.server.v1.Ban
+	(*Mute)(nil),                            // 64: confa.server.v1.Mute
+	(*KickMemberRequest)(nil),               // 65: confa.server.v1.KickMemberRequest
+	(*KickMemberResponse)(nil),              // 66: confa.server.v1.KickMemberResponse
+	(*BanMemberRequest)(nil),                // 67: confa.server.v1.BanMemberRequest
+	(*BanMemberResponse)(nil),               // 68: confa.server.v1.BanMemberResponse
+	(*UnbanMemberRequest)(nil),              // 69: confa.server.v1.UnbanMemberRequest
+	(*UnbanMemberResponse)(nil),             // 70: confa.server.v1.UnbanMemberResponse
+	(*ListBansRequest)(nil),                 // 71: confa.server.v1.ListBansRequest
+	(*ListBansResponse)(nil),                // 72: confa.server.v1.ListBansResponse
+	(*MuteMemberRequest)(nil),               // 73: confa.server.v1.MuteMemberRequest
+	(*MuteMemberResponse)(nil),              // 74: confa.server.v1.MuteMemberResponse
+	(*UnmuteMemberRequest)(nil),             // 75: confa.server.v1.UnmuteMemberRequest
+	(*UnmuteMemberResponse)(nil),            // 76: confa.server.v1.UnmuteMemberResponse
+	(*v1.Channel)(nil),                      // 77: confa.channel.v1.Channel
+	(*v11.User)(nil),                        // 78: confa.user.v1.User
+	(*timestamppb.Timestamp)(nil),           // 79: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),             // 80: google.protobuf.Duration
 }
 var file_confa_server_v1_service_proto_depIdxs = []int32{
-	10, // 0: confa.server.v1.ListChannelsResponse.channels:type_name -> confa.channel.v1.Channel
-	11, // 1: confa.server.v1.ListUsersResponse.users:type_name -> confa.user.v1.User
-	0,  // 2: confa.server.v1.CreateChannelRequest.type:type_name -> confa.server.v1.CreateChannelRequest.ChannelType
-	10, // 3: confa.server.v1.CreateChannelResponse.channel:type_name -> confa.channel.v1.Channel
-	1,  // 4: confa.server.v1.EditChannelRequest.type:type_name -> confa.server.v1.EditChannelRequest.ChannelType
-	10, // 5: confa.server.v1.EditChannelResponse.channel:type_name -> confa.channel.v1.Channel
-	2,  // 6: confa.server.v1.ServerService.ListChannels:input_type -> confa.server.v1.ListChannelsRequest
-	4,  // 7: confa.server.v1.ServerService.ListUsers:input_type -> confa.server.v1.ListUsersRequest
-	6,  // 8: confa.server.v1.ServerService.CreateChannel:input_type -> confa.server.v1.CreateChannelRequest
-	8,  // 9: confa.server.v1.ServerService.EditChannel:input_type -> confa.server.v1.EditChannelRequest
-	3,  // 10: confa.server.v1.ServerService.ListChannels:output_type -> confa.server.v1.ListChannelsResponse
-	5,  // 11: confa.server.v1.ServerService.ListUsers:output_type -> confa.server.v1.ListUsersResponse
-	7,  // 12: confa.server.v1.ServerService.CreateChannel:output_type -> confa.server.v1.CreateChannelResponse
-	9,  // 13: confa.server.v1.ServerService.EditChannel:output_type -> confa.server.v1.EditChannelResponse
-	10, // [10:14] is the sub-list for method output_type
-	6,  // [6:10] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	77, // 0: confa.server.v1.ListChannelsResponse.channels:type_name -> confa.channel.v1.Channel
+	78, // 1: confa.server.v1.ListUsersResponse.users:type_name -> confa.user.v1.User
+	8,  // 2: confa.server.v1.ListUsersResponse.members:type_name -> confa.server.v1.ServerMember
+	78, // 3: confa.server.v1.ServerMember.user:type_name -> confa.user.v1.User
+	79, // 4: confa.server.v1.ServerMember.joined_at:type_name -> google.protobuf.Timestamp
+	2,  // 5: confa.server.v1.CreateChannelRequest.type:type_name -> confa.server.v1.CreateChannelRequest.ChannelType
+	77, // 6: confa.server.v1.CreateChannelResponse.channel:type_name -> confa.channel.v1.Channel
+	3,  // 7: confa.server.v1.EditChannelRequest.type:type_name -> confa.server.v1.EditChannelRequest.ChannelType
+	77, // 8: confa.server.v1.EditChannelResponse.channel:type_name -> confa.channel.v1.Channel
+	80, // 9: confa.server.v1.SetChannelMessageTTLRequest.message_ttl:type_name -> google.protobuf.Duration
+	77, // 10: confa.server.v1.SetChannelMessageTTLResponse.channel:type_name -> confa.channel.v1.Channel
+	8,  // 11: confa.server.v1.SetNicknameResponse.member:type_name -> confa.server.v1.ServerMember
+	79, // 12: confa.server.v1.Invite.expires_at:type_name -> google.protobuf.Timestamp
+	79, // 13: confa.server.v1.Invite.created_at:type_name -> google.protobuf.Timestamp
+	80, // 14: confa.server.v1.CreateInviteRequest.expires_in:type_name -> google.protobuf.Duration
+	21, // 15: confa.server.v1.CreateInviteResponse.invite:type_name -> confa.server.v1.Invite
+	21, // 16: confa.server.v1.ListInvitesResponse.invites:type_name -> confa.server.v1.Invite
+	21, // 17: confa.server.v1.RedeemInviteResponse.invite:type_name -> confa.server.v1.Invite
+	8,  // 18: confa.server.v1.RedeemInviteResponse.member:type_name -> confa.server.v1.ServerMember
+	30, // 19: confa.server.v1.CreateServerResponse.server:type_name -> confa.server.v1.Server
+	30, // 20: confa.server.v1.GetServerResponse.server:type_name -> confa.server.v1.Server
+	30, // 21: confa.server.v1.RenameServerResponse.server:type_name -> confa.server.v1.Server
+	30, // 22: confa.server.v1.TransferServerOwnershipResponse.server:type_name -> confa.server.v1.Server
+	1,  // 23: confa.server.v1.ChannelOverwrite.target_type:type_name -> confa.server.v1.OverwriteTarget
+	41, // 24: confa.server.v1.CreateRoleResponse.role:type_name -> confa.server.v1.Role
+	41, // 25: confa.server.v1.ListRolesResponse.roles:type_name -> confa.server.v1.Role
+	41, // 26: confa.server.v1.UpdateRoleResponse.role:type_name -> confa.server.v1.Role
+	42, // 27: confa.server.v1.SetChannelOverwriteRequest.overwrite:type_name -> confa.server.v1.ChannelOverwrite
+	42, // 28: confa.server.v1.SetChannelOverwriteResponse.overwrite:type_name -> confa.server.v1.ChannelOverwrite
+	42, // 29: confa.server.v1.ListChannelOverwritesResponse.overwrites:type_name -> confa.server.v1.ChannelOverwrite
+	79, // 30: confa.server.v1.Ban.expires_at:type_name -> google.protobuf.Timestamp
+	79, // 31: confa.server.v1.Ban.created_at:type_name -> google.protobuf.Timestamp
+	79, // 32: confa.server.v1.Mute.expires_at:type_name -> google.protobuf.Timestamp
+	79, // 33: confa.server.v1.Mute.created_at:type_name -> google.protobuf.Timestamp
+	80, // 34: confa.server.v1.BanMemberRequest.duration:type_name -> google.protobuf.Duration
+	63, // 35: confa.server.v1.BanMemberResponse.ban:type_name -> confa.server.v1.Ban
+	63, // 36: confa.server.v1.ListBansResponse.bans:type_name -> confa.server.v1.Ban
+	80, // 37: confa.server.v1.MuteMemberRequest.duration:type_name -> google.protobuf.Duration
+	64, // 38: confa.server.v1.MuteMemberResponse.mute:type_name -> confa.server.v1.Mute
+	4,  // 39: confa.server.v1.ServerService.ListChannels:input_type -> confa.server.v1.ListChannelsRequest
+	6,  // 40: confa.server.v1.ServerService.ListUsers:input_type -> confa.server.v1.ListUsersRequest
+	9,  // 41: confa.server.v1.ServerService.CreateChannel:input_type -> confa.server.v1.CreateChannelRequest
+	11, // 42: confa.server.v1.ServerService.EditChannel:input_type -> confa.server.v1.EditChannelRequest
+	13, // 43: confa.server.v1.ServerService.SetChannelMessageTTL:input_type -> confa.server.v1.SetChannelMessageTTLRequest
+	15, // 44: confa.server.v1.ServerService.ExportChannel:input_type -> confa.server.v1.ExportChannelRequest
+	17, // 45: confa.server.v1.ServerService.LeaveServer:input_type -> confa.server.v1.LeaveServerRequest
+	19, // 46: confa.server.v1.ServerService.SetNickname:input_type -> confa.server.v1.SetNicknameRequest
+	22, // 47: confa.server.v1.ServerService.CreateInvite:input_type -> confa.server.v1.CreateInviteRequest
+	24, // 48: confa.server.v1.ServerService.ListInvites:input_type -> confa.server.v1.ListInvitesRequest
+	26, // 49: confa.server.v1.ServerService.RevokeInvite:input_type -> confa.server.v1.RevokeInviteRequest
+	28, // 50: confa.server.v1.ServerService.RedeemInvite:input_type -> confa.server.v1.RedeemInviteRequest
+	31, // 51: confa.server.v1.ServerService.CreateServer:input_type -> confa.server.v1.CreateServerRequest
+	33, // 52: confa.server.v1.ServerService.GetServer:input_type -> confa.server.v1.GetServerRequest
+	35, // 53: confa.server.v1.ServerService.RenameServer:input_type -> confa.server.v1.RenameServerRequest
+	37, // 54: confa.server.v1.ServerService.DeleteServer:input_type -> confa.server.v1.DeleteServerRequest
+	39, // 55: confa.server.v1.ServerService.TransferServerOwnership:input_type -> confa.server.v1.TransferServerOwnershipRequest
+	43, // 56: confa.server.v1.ServerService.CreateRole:input_type -> confa.server.v1.CreateRoleRequest
+	45, // 57: confa.server.v1.ServerService.ListRoles:input_type -> confa.server.v1.ListRolesRequest
+	47, // 58: confa.server.v1.ServerService.UpdateRole:input_type -> confa.server.v1.UpdateRoleRequest
+	49, // 59: confa.server.v1.ServerService.DeleteRole:input_type -> confa.server.v1.DeleteRoleRequest
+	51, // 60: confa.server.v1.ServerService.AssignRole:input_type -> confa.server.v1.AssignRoleRequest
+	53, // 61: confa.server.v1.ServerService.UnassignRole:input_type -> confa.server.v1.UnassignRoleRequest
+	55, // 62: confa.server.v1.ServerService.SetChannelOverwrite:input_type -> confa.server.v1.SetChannelOverwriteRequest
+	57, // 63: confa.server.v1.ServerService.DeleteChannelOverwrite:input_type -> confa.server.v1.DeleteChannelOverwriteRequest
+	59, // 64: confa.server.v1.ServerService.ListChannelOverwrites:input_type -> confa.server.v1.ListChannelOverwritesRequest
+	61, // 65: confa.server.v1.ServerService.GetPermissions:input_type -> confa.server.v1.GetPermissionsRequest
+	65, // 66: confa.server.v1.ServerService.KickMember:input_type -> confa.server.v1.KickMemberRequest
+	67, // 67: confa.server.v1.ServerService.BanMember:input_type -> confa.server.v1.BanMemberRequest
+	69, // 68: confa.server.v1.ServerService.UnbanMember:input_type -> confa.server.v1.UnbanMemberRequest
+	71, // 69: confa.server.v1.ServerService.ListBans:input_type -> confa.server.v1.ListBansRequest
+	73, // 70: confa.server.v1.ServerService.MuteMember:input_type -> confa.server.v1.MuteMemberRequest
+	75, // 71: confa.server.v1.ServerService.UnmuteMember:input_type -> confa.server.v1.UnmuteMemberRequest
+	5,  // 72: confa.server.v1.ServerService.ListChannels:output_type -> confa.server.v1.ListChannelsResponse
+	7,  // 73: confa.server.v1.ServerService.ListUsers:output_type -> confa.server.v1.ListUsersResponse
+	10, // 74: confa.server.v1.ServerService.CreateChannel:output_type -> confa.server.v1.CreateChannelResponse
+	12, // 75: confa.server.v1.ServerService.EditChannel:output_type -> confa.server.v1.EditChannelResponse
+	14, // 76: confa.server.v1.ServerService.SetChannelMessageTTL:output_type -> confa.server.v1.SetChannelMessageTTLResponse
+	16, // 77: confa.server.v1.ServerService.ExportChannel:output_type -> confa.server.v1.ExportChannelResponse
+	18, // 78: confa.server.v1.ServerService.LeaveServer:output_type -> confa.server.v1.LeaveServerResponse
+	20, // 79: confa.server.v1.ServerService.SetNickname:output_type -> confa.server.v1.SetNicknameResponse
+	23, // 80: confa.server.v1.ServerService.CreateInvite:output_type -> confa.server.v1.CreateInviteResponse
+	25, // 81: confa.server.v1.ServerService.ListInvites:output_type -> confa.server.v1.ListInvitesResponse
+	27, // 82: confa.server.v1.ServerService.RevokeInvite:output_type -> confa.server.v1.RevokeInviteResponse
+	29, // 83: confa.server.v1.ServerService.RedeemInvite:output_type -> confa.server.v1.RedeemInviteResponse
+	32, // 84: confa.server.v1.ServerService.CreateServer:output_type -> confa.server.v1.CreateServerResponse
+	34, // 85: confa.server.v1.ServerService.GetServer:output_type -> confa.server.v1.GetServerResponse
+	36, // 86: confa.server.v1.ServerService.RenameServer:output_type -> confa.server.v1.RenameServerResponse
+	38, // 87: confa.server.v1.ServerService.DeleteServer:output_type -> confa.server.v1.DeleteServerResponse
+	40, // 88: confa.server.v1.ServerService.TransferServerOwnership:output_type -> confa.server.v1.TransferServerOwnershipResponse
+	44, // 89: confa.server.v1.ServerService.CreateRole:output_type -> confa.server.v1.CreateRoleResponse
+	46, // 90: confa.server.v1.ServerService.ListRoles:output_type -> confa.server.v1.ListRolesResponse
+	48, // 91: confa.server.v1.ServerService.UpdateRole:output_type -> confa.server.v1.UpdateRoleResponse
+	50, // 92: confa.server.v1.ServerService.DeleteRole:output_type -> confa.server.v1.DeleteRoleResponse
+	52, // 93: confa.server.v1.ServerService.AssignRole:output_type -> confa.server.v1.AssignRoleResponse
+	54, // 94: confa.server.v1.ServerService.UnassignRole:output_type -> confa.server.v1.UnassignRoleResponse
+	56, // 95: confa.server.v1.ServerService.SetChannelOverwrite:output_type -> confa.server.v1.SetChannelOverwriteResponse
+	58, // 96: confa.server.v1.ServerService.DeleteChannelOverwrite:output_type -> confa.server.v1.DeleteChannelOverwriteResponse
+	60, // 97: confa.server.v1.ServerService.ListChannelOverwrites:output_type -> confa.server.v1.ListChannelOverwritesResponse
+	62, // 98: confa.server.v1.ServerService.GetPermissions:output_type -> confa.server.v1.GetPermissionsResponse
+	66, // 99: confa.server.v1.ServerService.KickMember:output_type -> confa.server.v1.KickMemberResponse
+	68, // 100: confa.server.v1.ServerService.BanMember:output_type -> confa.server.v1.BanMemberResponse
+	70, // 101: confa.server.v1.ServerService.UnbanMember:output_type -> confa.server.v1.UnbanMemberResponse
+	72, // 102: confa.server.v1.ServerService.ListBans:output_type -> confa.server.v1.ListBansResponse
+	74, // 103: confa.server.v1.ServerService.MuteMember:output_type -> confa.server.v1.MuteMemberResponse
+	76, // 104: confa.server.v1.ServerService.UnmuteMember:output_type -> confa.server.v1.UnmuteMemberResponse
+	72, // [72:105] is the sub-list for method output_type
+	39, // [39:72] is the sub-list for method input_type
+	39, // [39:39] is the sub-list for extension type_name
+	39, // [39:39] is the sub-list for extension extendee
+	0,  // [0:39] is the sub-list for field type_name
 }
 
 func init() { file_confa_server_v1_service_proto_init() }
@@ -607,8 +4385,8 @@ func file_confa_server_v1_service_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_confa_server_v1_service_proto_rawDesc), len(file_confa_server_v1_service_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   8,
+			NumEnums:      4,
+			NumMessages:   73,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
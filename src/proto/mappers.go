@@ -1,37 +1,127 @@
 package proto
 
 import (
-	"fmt"
+	"time"
 
+	"github.com/confa-chat/node/pkg/uuid"
+	"github.com/confa-chat/node/src/confa"
 	channelv1 "github.com/confa-chat/node/src/proto/confa/channel/v1"
 	chatv1 "github.com/confa-chat/node/src/proto/confa/chat/v1"
+	serverv1 "github.com/confa-chat/node/src/proto/confa/server/v1"
 	userv1 "github.com/confa-chat/node/src/proto/confa/user/v1"
 	"github.com/confa-chat/node/src/store"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func mapMessage(msg store.Message) *chatv1.Message {
-	protoMsg := &chatv1.Message{
-		MessageId: msg.ID.String(),
-		SenderId:  msg.SenderID.String(),
-		Content:   msg.Content,
-		Timestamp: timestamppb.New(msg.Timestamp),
+	return confa.MessageToProto(msg)
+}
+
+func mapMessageRevision(rev store.MessageRevision) *chatv1.MessageRevision {
+	return &chatv1.MessageRevision{
+		RevisionId: rev.ID.String(),
+		MessageId:  rev.MessageID.String(),
+		Content:    rev.Content,
+		Timestamp:  timestamppb.New(rev.Timestamp),
+	}
+}
+
+func mapMention(m store.Mention) *chatv1.Mention {
+	var msg store.Message
+	if m.Message != nil {
+		msg = *m.Message
+	}
+	var serverID uuid.UUID
+	if m.Channel != nil && m.Channel.ServerID != nil {
+		serverID = *m.Channel.ServerID
+	}
+	return confa.MentionToProto(m, serverID, msg)
+}
+
+// mapMessageEventType returns the legacy event type for clients that don't read the event envelope yet
+func mapMessageEventType(event *chatv1.MessageEvent) chatv1.MessageEventType {
+	switch event.Event.(type) {
+	case *chatv1.MessageEvent_Edited, *chatv1.MessageEvent_ThreadUpdated, *chatv1.MessageEvent_Reaction, *chatv1.MessageEvent_Pin:
+		return chatv1.MessageEventType_MESSAGE_EVENT_TYPE_EDITED
+	case *chatv1.MessageEvent_Deleted:
+		return chatv1.MessageEventType_MESSAGE_EVENT_TYPE_DELETED
+	default:
+		return chatv1.MessageEventType_MESSAGE_EVENT_TYPE_CREATED
+	}
+}
+
+// eventMessageID returns the ID of the message the event refers to
+func eventMessageID(event *chatv1.MessageEvent) string {
+	switch e := event.Event.(type) {
+	case *chatv1.MessageEvent_Created:
+		return e.Created.GetMessage().GetMessageId()
+	case *chatv1.MessageEvent_Edited:
+		return e.Edited.GetMessage().GetMessageId()
+	case *chatv1.MessageEvent_Deleted:
+		return e.Deleted.GetMessage().GetMessageId()
+	case *chatv1.MessageEvent_ThreadUpdated:
+		return e.ThreadUpdated.GetMessage().GetMessageId()
+	case *chatv1.MessageEvent_Reaction:
+		return e.Reaction.GetMessageId()
+	case *chatv1.MessageEvent_Pin:
+		return e.Pin.GetMessage().GetMessageId()
+	default:
+		return ""
+	}
+}
+
+func mapPinnedMessage(p store.PinnedMessage) *chatv1.PinnedMessage {
+	pin := &chatv1.PinnedMessage{
+		PinnedAt: timestamppb.New(p.PinnedAt),
 	}
+	if p.Message != nil {
+		pin.Message = mapMessage(*p.Message)
+	}
+	if p.PinnedBy != nil {
+		pin.PinnedBy = p.PinnedBy.String()
+	}
+	return pin
+}
 
-	// Map attachments if any exist
-	if len(msg.Attachments) > 0 {
-		protoMsg.Attachments = make([]*chatv1.Attachment, len(msg.Attachments))
+func mapScheduledMessage(s store.ScheduledMessage) *chatv1.ScheduledMessage {
+	msg := &chatv1.ScheduledMessage{
+		ScheduledMessageId: s.ID.String(),
+		Channel:            mapChannelRef(s.ServerID, s.ChannelID),
+		Content:            s.Content,
+		SendAt:             timestamppb.New(s.SendAt),
+		CreatedAt:          timestamppb.New(s.CreatedAt),
+	}
+	if s.ReplyToID != nil {
+		msg.ReplyToMessageId = s.ReplyToID.String()
+	}
+	for _, a := range s.Attachments {
+		msg.AttachmentIds = append(msg.AttachmentIds, a.ID.String())
+	}
+	return msg
+}
 
-		for i, attachment := range msg.Attachments {
-			protoMsg.Attachments[i] = &chatv1.Attachment{
-				AttachmentId: attachment.AttachmentID.String(),
-				Name:         attachment.Name,
-				Url:          fmt.Sprintf("/attachments/%s/%s", attachment.AttachmentID.String(), attachment.Name),
-			}
-		}
+// mapChannelRef returns the reference of a channel, the server ID is left empty for channels outside of servers
+func mapChannelRef(serverID *uuid.UUID, channelID uuid.UUID) *chatv1.TextChannelRef {
+	ref := &chatv1.TextChannelRef{ChannelId: channelID.String()}
+	if serverID != nil {
+		ref.ServerId = serverID.String()
 	}
+	return ref
+}
 
-	return protoMsg
+func mapDirectMessage(dm confa.DirectMessage) *chatv1.DirectMessage {
+	channel := mapTextChannel(dm.Channel)
+	setChannelUnread(channel, dm.Unread)
+	protoDM := &chatv1.DirectMessage{
+		Channel:        channel,
+		ParticipantIds: apply(dm.ParticipantIDs, uuid.UUID.String),
+		LastActivityAt: timestamppb.New(dm.LastActivityAt),
+	}
+	if dm.Channel.OwnerID != nil {
+		protoDM.OwnerId = dm.Channel.OwnerID.String()
+	}
+	return protoDM
 }
 
 func mapTextChannelToChannel(c store.TextChannel) *channelv1.Channel {
@@ -51,11 +141,25 @@ func mapVoiceChannelToChannel(c store.VoiceChannel) *channelv1.Channel {
 }
 
 func mapTextChannel(c store.TextChannel) *channelv1.TextChannel {
-	return &channelv1.TextChannel{
-		ServerId:  c.ServerID.String(),
+	channel := &channelv1.TextChannel{
 		ChannelId: c.ID.String(),
 		Name:      c.Name,
 	}
+	if c.ServerID != nil {
+		channel.ServerId = c.ServerID.String()
+	}
+	if c.MessageTTLSeconds != nil {
+		channel.MessageTtl = durationpb.New(time.Duration(*c.MessageTTLSeconds) * time.Second)
+	}
+	return channel
+}
+
+func setChannelUnread(c *channelv1.TextChannel, unread confa.ChannelUnread) {
+	c.UnreadCount = int32(unread.UnreadCount)
+	c.MentionCount = int32(unread.MentionCount)
+	if unread.LastReadMessageID != nil {
+		c.LastReadMessageId = unread.LastReadMessageID.String()
+	}
 }
 
 func mapVoiceChannel(c store.VoiceChannel) *channelv1.VoiceChannel {
@@ -67,6 +171,104 @@ func mapVoiceChannel(c store.VoiceChannel) *channelv1.VoiceChannel {
 	}
 }
 
+func mapServer(s store.Server) *serverv1.Server {
+	server := &serverv1.Server{
+		Id:   s.ID.String(),
+		Name: s.Name,
+	}
+	if s.OwnerID != nil {
+		server.OwnerId = s.OwnerID.String()
+	}
+	return server
+}
+
+func mapServerMember(m store.ServerMember) *serverv1.ServerMember {
+	member := &serverv1.ServerMember{
+		Nickname: m.Nickname,
+		JoinedAt: timestamppb.New(m.JoinedAt),
+	}
+	if m.User != nil {
+		member.User = mapUser(*m.User)
+	}
+	for _, roleID := range m.RoleIDs {
+		member.RoleIds = append(member.RoleIds, roleID.String())
+	}
+	return member
+}
+
+func mapRole(r store.Role) *serverv1.Role {
+	return &serverv1.Role{
+		Id:          r.ID.String(),
+		ServerId:    r.ServerID.String(),
+		Name:        r.Name,
+		Permissions: uint64(r.Permissions),
+	}
+}
+
+func mapChannelOverwrite(o store.ChannelOverwrite) *serverv1.ChannelOverwrite {
+	overwrite := &serverv1.ChannelOverwrite{
+		ChannelId: o.ChannelID.String(),
+		TargetId:  o.TargetID.String(),
+		Allow:     uint64(o.Allow),
+		Deny:      uint64(o.Deny),
+	}
+	switch o.TargetType {
+	case store.OverwriteTargetRole:
+		overwrite.TargetType = serverv1.OverwriteTarget_OVERWRITE_TARGET_ROLE
+	case store.OverwriteTargetMember:
+		overwrite.TargetType = serverv1.OverwriteTarget_OVERWRITE_TARGET_MEMBER
+	}
+	return overwrite
+}
+
+func mapInvite(i store.ServerInvite) *serverv1.Invite {
+	invite := &serverv1.Invite{
+		Code:      i.Code,
+		ServerId:  i.ServerID.String(),
+		CreatorId: i.CreatorID.String(),
+		MaxUses:   int32(i.MaxUses),
+		Uses:      int32(i.Uses),
+		CreatedAt: timestamppb.New(i.CreatedAt),
+	}
+	if i.ChannelID != nil {
+		invite.ChannelId = i.ChannelID.String()
+	}
+	if i.ExpiresAt != nil {
+		invite.ExpiresAt = timestamppb.New(*i.ExpiresAt)
+	}
+	return invite
+}
+
+func mapBan(b store.ServerBan) *serverv1.Ban {
+	ban := &serverv1.Ban{
+		ServerId:  b.ServerID.String(),
+		UserId:    b.UserID.String(),
+		Reason:    b.Reason,
+		CreatedAt: timestamppb.New(b.CreatedAt),
+	}
+	if b.ModeratorID != nil {
+		ban.ModeratorId = b.ModeratorID.String()
+	}
+	if b.ExpiresAt != nil {
+		ban.ExpiresAt = timestamppb.New(*b.ExpiresAt)
+	}
+	return ban
+}
+
+func mapMute(m store.ServerMute) *serverv1.Mute {
+	mute := &serverv1.Mute{
+		ServerId:  m.ServerID.String(),
+		UserId:    m.UserID.String(),
+		Reason:    m.Reason,
+		ExpiresAt: timestamppb.New(m.ExpiresAt),
+		CreatedAt: timestamppb.New(m.CreatedAt),
+	}
+	if m.ModeratorID != nil {
+		mute.ModeratorId = m.ModeratorID.String()
+	}
+	return mute
+}
+
 func mapUser(c store.User) *userv1.User {
 	return &userv1.User{
 		Id:       c.ID.String(),
@@ -55,7 +55,12 @@ func (h *NodeService) ListVoiceRelays(context.Context, *nodev1.ListVoiceRelaysRe
 
 // ListServers implements nodev1.HubServiceServer.
 func (h *NodeService) ListServerIDs(ctx context.Context, req *nodev1.ListServersRequest) (*nodev1.ListServersResponse, error) {
-	servers, err := h.srv.ListServers(ctx)
+	user := auth.CtxGetUser(ctx)
+	if user == nil {
+		return nil, ErrUnauthenticated
+	}
+
+	servers, err := h.srv.ListUserServers(ctx, user.ID)
 	if err != nil {
 		return nil, err
 	}
@@ -1,14 +1,21 @@
 package proto
 
 import (
+	"bufio"
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 
 	"github.com/confa-chat/node/pkg/uuid"
+	"github.com/confa-chat/node/src/auth"
 	"github.com/confa-chat/node/src/confa"
 	channelv1 "github.com/confa-chat/node/src/proto/confa/channel/v1"
 	serverv1 "github.com/confa-chat/node/src/proto/confa/server/v1"
 	"github.com/confa-chat/node/src/store"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func NewServerService(srv *confa.Service) *ServerService {
@@ -23,15 +30,32 @@ var _ serverv1.ServerServiceServer = (*ServerService)(nil)
 
 // ListChannels implements serverv1.ServerServiceServer.
 func (s *ServerService) ListChannels(ctx context.Context, req *serverv1.ListChannelsRequest) (*serverv1.ListChannelsResponse, error) {
+	user := auth.CtxGetUser(ctx)
+	if user == nil {
+		return nil, ErrUnauthenticated
+	}
+
 	serverID, err := uuid.FromString(req.ServerId)
 	if err != nil {
 		return nil, err
 	}
+	if err := s.checkServerAccess(ctx, user.ID, serverID); err != nil {
+		return nil, err
+	}
 
 	textChannels, err := s.srv.ListTextChannelsOnServer(ctx, serverID)
 	if err != nil {
 		return nil, err
 	}
+	textChannels, err = s.srv.VisibleChannels(ctx, user.ID, serverID, textChannels)
+	if err != nil {
+		return nil, err
+	}
+
+	unreads, err := s.srv.ListUnreadCounts(ctx, user.ID, serverID)
+	if err != nil {
+		return nil, err
+	}
 
 	voiceChannels := []store.VoiceChannel{{
 		ID:       serverID,
@@ -41,7 +65,13 @@ func (s *ServerService) ListChannels(ctx context.Context, req *serverv1.ListChan
 	}}
 
 	channels := make([]*channelv1.Channel, 0, len(textChannels)+len(voiceChannels))
-	channels = append(channels, apply(textChannels, mapTextChannelToChannel)...)
+	for _, textChannel := range textChannels {
+		channel := mapTextChannelToChannel(textChannel)
+		if unread, ok := unreads[textChannel.ID]; ok {
+			setChannelUnread(channel.GetTextChannel(), unread)
+		}
+		channels = append(channels, channel)
+	}
 	channels = append(channels, apply(voiceChannels, mapVoiceChannelToChannel)...)
 
 	return &serverv1.ListChannelsResponse{
@@ -51,27 +81,51 @@ func (s *ServerService) ListChannels(ctx context.Context, req *serverv1.ListChan
 
 // ListUsers implements serverv1.ServerServiceServer.
 func (s *ServerService) ListUsers(ctx context.Context, req *serverv1.ListUsersRequest) (*serverv1.ListUsersResponse, error) {
+	user := auth.CtxGetUser(ctx)
+	if user == nil {
+		return nil, ErrUnauthenticated
+	}
+
 	serverID, err := uuid.FromString(req.ServerId)
 	if err != nil {
 		return nil, err
 	}
+	if err := s.checkServerAccess(ctx, user.ID, serverID); err != nil {
+		return nil, err
+	}
 
-	users, err := s.srv.ListServerUser(ctx, serverID)
+	members, err := s.srv.ListServerMembers(ctx, serverID)
 	if err != nil {
 		return nil, err
 	}
 
+	users := make([]store.User, 0, len(members))
+	for _, member := range members {
+		if member.User != nil {
+			users = append(users, *member.User)
+		}
+	}
+
 	return &serverv1.ListUsersResponse{
-		Users: apply(users, mapUser),
+		Users:   apply(users, mapUser),
+		Members: apply(members, mapServerMember),
 	}, nil
 }
 
 // CreateChannel implements serverv1.ServerServiceServer.
 func (s *ServerService) CreateChannel(ctx context.Context, req *serverv1.CreateChannelRequest) (*serverv1.CreateChannelResponse, error) {
+	user := auth.CtxGetUser(ctx)
+	if user == nil {
+		return nil, ErrUnauthenticated
+	}
+
 	serverID, err := uuid.FromString(req.ServerId)
 	if err != nil {
 		return nil, fmt.Errorf("invalid server ID: %w", err)
 	}
+	if err := s.checkPermission(ctx, user.ID, serverID, uuid.Nil, confa.PermissionManageChannels); err != nil {
+		return nil, err
+	}
 
 	var channelID uuid.UUID
 	var channel *channelv1.Channel
@@ -124,6 +178,11 @@ func (s *ServerService) CreateChannel(ctx context.Context, req *serverv1.CreateC
 
 // EditChannel implements serverv1.ServerServiceServer.
 func (s *ServerService) EditChannel(ctx context.Context, req *serverv1.EditChannelRequest) (*serverv1.EditChannelResponse, error) {
+	user := auth.CtxGetUser(ctx)
+	if user == nil {
+		return nil, ErrUnauthenticated
+	}
+
 	serverID, err := uuid.FromString(req.ServerId)
 	if err != nil {
 		return nil, fmt.Errorf("invalid server ID: %w", err)
@@ -134,6 +193,15 @@ func (s *ServerService) EditChannel(ctx context.Context, req *serverv1.EditChann
 		return nil, fmt.Errorf("invalid channel ID: %w", err)
 	}
 
+	// Text channels are checked with their overwrites on the server they belong to
+	permChannelID := uuid.Nil
+	if req.Type == serverv1.EditChannelRequest_TEXT {
+		permChannelID = channelID
+	}
+	if err := s.checkPermission(ctx, user.ID, serverID, permChannelID, confa.PermissionManageChannels); err != nil {
+		return nil, err
+	}
+
 	var channel *channelv1.Channel
 
 	// Update either a text or voice channel based on the type
@@ -183,3 +251,815 @@ func (s *ServerService) EditChannel(ctx context.Context, req *serverv1.EditChann
 		Channel: channel,
 	}, nil
 }
+
+// SetChannelMessageTTL implements serverv1.ServerServiceServer.
+func (s *ServerService) SetChannelMessageTTL(ctx context.Context, req *serverv1.SetChannelMessageTTLRequest) (*serverv1.SetChannelMessageTTLResponse, error) {
+	user := auth.CtxGetUser(ctx)
+	if user == nil {
+		return nil, ErrUnauthenticated
+	}
+
+	serverID, err := uuid.FromString(req.ServerId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid server ID: %v", err)
+	}
+
+	channelID, err := uuid.FromString(req.ChannelId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid channel ID: %v", err)
+	}
+	if err := s.checkPermission(ctx, user.ID, serverID, channelID, confa.PermissionManageChannels); err != nil {
+		return nil, err
+	}
+
+	channel, err := s.srv.SetChannelMessageTTL(ctx, user.ID, serverID, channelID, req.MessageTtl.AsDuration())
+	switch {
+	case errors.Is(err, confa.ErrPermissionDenied):
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, confa.ErrInvalidTTL):
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, sql.ErrNoRows):
+		return nil, status.Error(codes.NotFound, "channel not found")
+	case err != nil:
+		return nil, err
+	}
+
+	return &serverv1.SetChannelMessageTTLResponse{
+		Channel: mapTextChannelToChannel(channel),
+	}, nil
+}
+
+// exportChunkSize is the size of the archive chunks sent to the client
+const exportChunkSize = 64 << 10
+
+// ExportChannel implements serverv1.ServerServiceServer.
+func (s *ServerService) ExportChannel(req *serverv1.ExportChannelRequest, out grpc.ServerStreamingServer[serverv1.ExportChannelResponse]) error {
+	ctx := out.Context()
+	user := auth.CtxGetUser(ctx)
+	if user == nil {
+		return ErrUnauthenticated
+	}
+
+	serverID, err := uuid.FromString(req.ServerId)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid server ID: %v", err)
+	}
+
+	channelID, err := uuid.FromString(req.ChannelId)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid channel ID: %v", err)
+	}
+
+	if err := s.checkPermission(ctx, user.ID, serverID, channelID, confa.PermissionManageServer); err != nil {
+		return err
+	}
+
+	w := bufio.NewWriterSize(streamWriter(func(p []byte) error {
+		return out.Send(&serverv1.ExportChannelResponse{Chunk: p})
+	}), exportChunkSize)
+
+	err = s.srv.ExportChannel(ctx, serverID, channelID, w)
+	if errors.Is(err, sql.ErrNoRows) {
+		return status.Error(codes.NotFound, "channel not found")
+	}
+	if err != nil {
+		return err
+	}
+
+	return w.Flush()
+}
+
+// LeaveServer implements serverv1.ServerServiceServer.
+func (s *ServerService) LeaveServer(ctx context.Context, req *serverv1.LeaveServerRequest) (*serverv1.LeaveServerResponse, error) {
+	user := auth.CtxGetUser(ctx)
+	if user == nil {
+		return nil, ErrUnauthenticated
+	}
+
+	serverID, err := uuid.FromString(req.ServerId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid server ID: %v", err)
+	}
+
+	err = s.srv.LeaveServer(ctx, serverID, user.ID)
+	if errors.Is(err, confa.ErrNotServerMember) || errors.Is(err, confa.ErrServerOwner) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &serverv1.LeaveServerResponse{}, nil
+}
+
+// SetNickname implements serverv1.ServerServiceServer.
+func (s *ServerService) SetNickname(ctx context.Context, req *serverv1.SetNicknameRequest) (*serverv1.SetNicknameResponse, error) {
+	user := auth.CtxGetUser(ctx)
+	if user == nil {
+		return nil, ErrUnauthenticated
+	}
+
+	serverID, err := uuid.FromString(req.ServerId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid server ID: %v", err)
+	}
+
+	member, err := s.srv.SetServerNickname(ctx, serverID, user.ID, req.Nickname)
+	switch {
+	case errors.Is(err, confa.ErrNotServerMember):
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, confa.ErrInvalidNickname):
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case err != nil:
+		return nil, err
+	}
+
+	return &serverv1.SetNicknameResponse{
+		Member: mapServerMember(member),
+	}, nil
+}
+
+// CreateInvite implements serverv1.ServerServiceServer.
+func (s *ServerService) CreateInvite(ctx context.Context, req *serverv1.CreateInviteRequest) (*serverv1.CreateInviteResponse, error) {
+	user := auth.CtxGetUser(ctx)
+	if user == nil {
+		return nil, ErrUnauthenticated
+	}
+
+	serverID, err := uuid.FromString(req.ServerId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid server ID: %v", err)
+	}
+
+	channelID, err := parseOptionalID(req.ChannelId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid channel ID: %v", err)
+	}
+
+	opts := confa.InviteOptions{
+		MaxUses:   int(req.MaxUses),
+		ExpiresIn: req.ExpiresIn.AsDuration(),
+	}
+	if channelID != uuid.Nil {
+		opts.ChannelID = &channelID
+	}
+
+	invite, err := s.srv.CreateInvite(ctx, user.ID, serverID, opts)
+	if err != nil {
+		return nil, mapInviteError(err)
+	}
+
+	return &serverv1.CreateInviteResponse{
+		Invite: mapInvite(invite),
+	}, nil
+}
+
+// ListInvites implements serverv1.ServerServiceServer.
+func (s *ServerService) ListInvites(ctx context.Context, req *serverv1.ListInvitesRequest) (*serverv1.ListInvitesResponse, error) {
+	user := auth.CtxGetUser(ctx)
+	if user == nil {
+		return nil, ErrUnauthenticated
+	}
+
+	serverID, err := uuid.FromString(req.ServerId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid server ID: %v", err)
+	}
+
+	invites, err := s.srv.ListInvites(ctx, user.ID, serverID)
+	if err != nil {
+		return nil, mapInviteError(err)
+	}
+
+	return &serverv1.ListInvitesResponse{
+		Invites: apply(invites, mapInvite),
+	}, nil
+}
+
+// RevokeInvite implements serverv1.ServerServiceServer.
+func (s *ServerService) RevokeInvite(ctx context.Context, req *serverv1.RevokeInviteRequest) (*serverv1.RevokeInviteResponse, error) {
+	user := auth.CtxGetUser(ctx)
+	if user == nil {
+		return nil, ErrUnauthenticated
+	}
+
+	if err := s.srv.RevokeInvite(ctx, user.ID, req.Code); err != nil {
+		return nil, mapInviteError(err)
+	}
+
+	return &serverv1.RevokeInviteResponse{}, nil
+}
+
+// RedeemInvite implements serverv1.ServerServiceServer.
+func (s *ServerService) RedeemInvite(ctx context.Context, req *serverv1.RedeemInviteRequest) (*serverv1.RedeemInviteResponse, error) {
+	user := auth.CtxGetUser(ctx)
+	if user == nil {
+		return nil, ErrUnauthenticated
+	}
+
+	invite, member, err := s.srv.RedeemInvite(ctx, user.ID, req.Code)
+	if err != nil {
+		return nil, mapInviteError(err)
+	}
+
+	return &serverv1.RedeemInviteResponse{
+		Invite: mapInvite(invite),
+		Member: mapServerMember(member),
+	}, nil
+}
+
+// mapInviteError converts invite errors from the service to gRPC status errors
+func mapInviteError(err error) error {
+	switch {
+	case errors.Is(err, confa.ErrInvalidInvite):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, confa.ErrInvalidInviteOptions):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, confa.ErrPermissionDenied), errors.Is(err, confa.ErrBanned):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, sql.ErrNoRows):
+		return status.Error(codes.NotFound, "invite or channel not found")
+	default:
+		return err
+	}
+}
+
+// CreateServer implements serverv1.ServerServiceServer.
+func (s *ServerService) CreateServer(ctx context.Context, req *serverv1.CreateServerRequest) (*serverv1.CreateServerResponse, error) {
+	user := auth.CtxGetUser(ctx)
+	if user == nil {
+		return nil, ErrUnauthenticated
+	}
+
+	serverID, err := s.srv.CreateServer(ctx, user.ID, req.Name)
+	if err != nil {
+		return nil, mapServerError(err)
+	}
+
+	server, err := s.srv.GetServer(ctx, serverID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &serverv1.CreateServerResponse{
+		Server: mapServer(server),
+	}, nil
+}
+
+// GetServer implements serverv1.ServerServiceServer.
+func (s *ServerService) GetServer(ctx context.Context, req *serverv1.GetServerRequest) (*serverv1.GetServerResponse, error) {
+	user := auth.CtxGetUser(ctx)
+	if user == nil {
+		return nil, ErrUnauthenticated
+	}
+
+	serverID, err := uuid.FromString(req.ServerId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid server ID: %v", err)
+	}
+	if err := s.checkServerAccess(ctx, user.ID, serverID); err != nil {
+		return nil, err
+	}
+
+	server, err := s.srv.GetServer(ctx, serverID)
+	if err != nil {
+		return nil, mapServerError(err)
+	}
+
+	return &serverv1.GetServerResponse{
+		Server: mapServer(server),
+	}, nil
+}
+
+// RenameServer implements serverv1.ServerServiceServer.
+func (s *ServerService) RenameServer(ctx context.Context, req *serverv1.RenameServerRequest) (*serverv1.RenameServerResponse, error) {
+	user := auth.CtxGetUser(ctx)
+	if user == nil {
+		return nil, ErrUnauthenticated
+	}
+
+	serverID, err := uuid.FromString(req.ServerId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid server ID: %v", err)
+	}
+
+	server, err := s.srv.RenameServer(ctx, user.ID, serverID, req.Name)
+	if err != nil {
+		return nil, mapServerError(err)
+	}
+
+	return &serverv1.RenameServerResponse{
+		Server: mapServer(server),
+	}, nil
+}
+
+// DeleteServer implements serverv1.ServerServiceServer.
+func (s *ServerService) DeleteServer(ctx context.Context, req *serverv1.DeleteServerRequest) (*serverv1.DeleteServerResponse, error) {
+	user := auth.CtxGetUser(ctx)
+	if user == nil {
+		return nil, ErrUnauthenticated
+	}
+
+	serverID, err := uuid.FromString(req.ServerId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid server ID: %v", err)
+	}
+
+	if err := s.srv.DeleteServer(ctx, user.ID, serverID); err != nil {
+		return nil, mapServerError(err)
+	}
+
+	return &serverv1.DeleteServerResponse{}, nil
+}
+
+// TransferServerOwnership implements serverv1.ServerServiceServer.
+func (s *ServerService) TransferServerOwnership(ctx context.Context, req *serverv1.TransferServerOwnershipRequest) (*serverv1.TransferServerOwnershipResponse, error) {
+	user := auth.CtxGetUser(ctx)
+	if user == nil {
+		return nil, ErrUnauthenticated
+	}
+
+	serverID, err := uuid.FromString(req.ServerId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid server ID: %v", err)
+	}
+
+	newOwnerID, err := uuid.FromString(req.NewOwnerId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+	}
+
+	server, err := s.srv.TransferServerOwnership(ctx, user.ID, serverID, newOwnerID)
+	if err != nil {
+		return nil, mapServerError(err)
+	}
+
+	return &serverv1.TransferServerOwnershipResponse{
+		Server: mapServer(server),
+	}, nil
+}
+
+// mapServerError converts server lifecycle errors from the service to gRPC status errors
+func mapServerError(err error) error {
+	switch {
+	case errors.Is(err, confa.ErrInvalidServerName):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, confa.ErrPermissionDenied):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, confa.ErrNotServerMember), errors.Is(err, confa.ErrDefaultServer):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, sql.ErrNoRows):
+		return status.Error(codes.NotFound, "server not found")
+	default:
+		return err
+	}
+}
+
+// CreateRole implements serverv1.ServerServiceServer.
+func (s *ServerService) CreateRole(ctx context.Context, req *serverv1.CreateRoleRequest) (*serverv1.CreateRoleResponse, error) {
+	user := auth.CtxGetUser(ctx)
+	if user == nil {
+		return nil, ErrUnauthenticated
+	}
+
+	serverID, err := uuid.FromString(req.ServerId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid server ID: %v", err)
+	}
+	if err := s.checkPermission(ctx, user.ID, serverID, uuid.Nil, confa.PermissionManageRoles); err != nil {
+		return nil, err
+	}
+
+	role, err := s.srv.CreateRole(ctx, user.ID, serverID, req.Name, confa.Permission(req.Permissions))
+	if err != nil {
+		return nil, mapRoleError(err)
+	}
+
+	return &serverv1.CreateRoleResponse{
+		Role: mapRole(role),
+	}, nil
+}
+
+// ListRoles implements serverv1.ServerServiceServer.
+func (s *ServerService) ListRoles(ctx context.Context, req *serverv1.ListRolesRequest) (*serverv1.ListRolesResponse, error) {
+	user := auth.CtxGetUser(ctx)
+	if user == nil {
+		return nil, ErrUnauthenticated
+	}
+
+	serverID, err := uuid.FromString(req.ServerId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid server ID: %v", err)
+	}
+	if err := s.checkServerAccess(ctx, user.ID, serverID); err != nil {
+		return nil, err
+	}
+
+	roles, err := s.srv.ListRoles(ctx, user.ID, serverID)
+	if err != nil {
+		return nil, mapRoleError(err)
+	}
+
+	return &serverv1.ListRolesResponse{
+		Roles: apply(roles, mapRole),
+	}, nil
+}
+
+// UpdateRole implements serverv1.ServerServiceServer.
+func (s *ServerService) UpdateRole(ctx context.Context, req *serverv1.UpdateRoleRequest) (*serverv1.UpdateRoleResponse, error) {
+	user := auth.CtxGetUser(ctx)
+	if user == nil {
+		return nil, ErrUnauthenticated
+	}
+
+	roleID, err := uuid.FromString(req.RoleId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid role ID: %v", err)
+	}
+
+	role, err := s.srv.UpdateRole(ctx, user.ID, roleID, req.Name, confa.Permission(req.Permissions))
+	if err != nil {
+		return nil, mapRoleError(err)
+	}
+
+	return &serverv1.UpdateRoleResponse{
+		Role: mapRole(role),
+	}, nil
+}
+
+// DeleteRole implements serverv1.ServerServiceServer.
+func (s *ServerService) DeleteRole(ctx context.Context, req *serverv1.DeleteRoleRequest) (*serverv1.DeleteRoleResponse, error) {
+	user := auth.CtxGetUser(ctx)
+	if user == nil {
+		return nil, ErrUnauthenticated
+	}
+
+	roleID, err := uuid.FromString(req.RoleId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid role ID: %v", err)
+	}
+
+	if err := s.srv.DeleteRole(ctx, user.ID, roleID); err != nil {
+		return nil, mapRoleError(err)
+	}
+
+	return &serverv1.DeleteRoleResponse{}, nil
+}
+
+// AssignRole implements serverv1.ServerServiceServer.
+func (s *ServerService) AssignRole(ctx context.Context, req *serverv1.AssignRoleRequest) (*serverv1.AssignRoleResponse, error) {
+	user := auth.CtxGetUser(ctx)
+	if user == nil {
+		return nil, ErrUnauthenticated
+	}
+
+	roleID, memberID, err := parseRoleAssignment(req.RoleId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.srv.AssignRole(ctx, user.ID, memberID, roleID); err != nil {
+		return nil, mapRoleError(err)
+	}
+
+	return &serverv1.AssignRoleResponse{}, nil
+}
+
+// UnassignRole implements serverv1.ServerServiceServer.
+func (s *ServerService) UnassignRole(ctx context.Context, req *serverv1.UnassignRoleRequest) (*serverv1.UnassignRoleResponse, error) {
+	user := auth.CtxGetUser(ctx)
+	if user == nil {
+		return nil, ErrUnauthenticated
+	}
+
+	roleID, memberID, err := parseRoleAssignment(req.RoleId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.srv.UnassignRole(ctx, user.ID, memberID, roleID); err != nil {
+		return nil, mapRoleError(err)
+	}
+
+	return &serverv1.UnassignRoleResponse{}, nil
+}
+
+// SetChannelOverwrite implements serverv1.ServerServiceServer.
+func (s *ServerService) SetChannelOverwrite(ctx context.Context, req *serverv1.SetChannelOverwriteRequest) (*serverv1.SetChannelOverwriteResponse, error) {
+	user := auth.CtxGetUser(ctx)
+	if user == nil {
+		return nil, ErrUnauthenticated
+	}
+	if req.Overwrite == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing overwrite")
+	}
+
+	overwrite := store.ChannelOverwrite{
+		Allow: int64(req.Overwrite.Allow),
+		Deny:  int64(req.Overwrite.Deny),
+	}
+
+	var err error
+	if overwrite.ChannelID, err = uuid.FromString(req.Overwrite.ChannelId); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid channel ID: %v", err)
+	}
+	if overwrite.TargetID, err = uuid.FromString(req.Overwrite.TargetId); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid target ID: %v", err)
+	}
+	switch req.Overwrite.TargetType {
+	case serverv1.OverwriteTarget_OVERWRITE_TARGET_ROLE:
+		overwrite.TargetType = store.OverwriteTargetRole
+	case serverv1.OverwriteTarget_OVERWRITE_TARGET_MEMBER:
+		overwrite.TargetType = store.OverwriteTargetMember
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid target type")
+	}
+
+	if err := s.checkPermission(ctx, user.ID, uuid.Nil, overwrite.ChannelID, confa.PermissionManageRoles); err != nil {
+		return nil, err
+	}
+
+	overwrite, err = s.srv.SetChannelOverwrite(ctx, user.ID, overwrite)
+	if err != nil {
+		return nil, mapRoleError(err)
+	}
+
+	return &serverv1.SetChannelOverwriteResponse{
+		Overwrite: mapChannelOverwrite(overwrite),
+	}, nil
+}
+
+// DeleteChannelOverwrite implements serverv1.ServerServiceServer.
+func (s *ServerService) DeleteChannelOverwrite(ctx context.Context, req *serverv1.DeleteChannelOverwriteRequest) (*serverv1.DeleteChannelOverwriteResponse, error) {
+	user := auth.CtxGetUser(ctx)
+	if user == nil {
+		return nil, ErrUnauthenticated
+	}
+
+	channelID, err := uuid.FromString(req.ChannelId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid channel ID: %v", err)
+	}
+	targetID, err := uuid.FromString(req.TargetId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid target ID: %v", err)
+	}
+	if err := s.checkPermission(ctx, user.ID, uuid.Nil, channelID, confa.PermissionManageRoles); err != nil {
+		return nil, err
+	}
+
+	if err := s.srv.DeleteChannelOverwrite(ctx, user.ID, channelID, targetID); err != nil {
+		return nil, mapRoleError(err)
+	}
+
+	return &serverv1.DeleteChannelOverwriteResponse{}, nil
+}
+
+// ListChannelOverwrites implements serverv1.ServerServiceServer.
+func (s *ServerService) ListChannelOverwrites(ctx context.Context, req *serverv1.ListChannelOverwritesRequest) (*serverv1.ListChannelOverwritesResponse, error) {
+	user := auth.CtxGetUser(ctx)
+	if user == nil {
+		return nil, ErrUnauthenticated
+	}
+
+	channelID, err := uuid.FromString(req.ChannelId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid channel ID: %v", err)
+	}
+	if err := s.checkPermission(ctx, user.ID, uuid.Nil, channelID, confa.PermissionViewChannel); err != nil {
+		return nil, err
+	}
+
+	overwrites, err := s.srv.ListChannelOverwrites(ctx, user.ID, channelID)
+	if err != nil {
+		return nil, mapRoleError(err)
+	}
+
+	return &serverv1.ListChannelOverwritesResponse{
+		Overwrites: apply(overwrites, mapChannelOverwrite),
+	}, nil
+}
+
+// GetPermissions implements serverv1.ServerServiceServer.
+func (s *ServerService) GetPermissions(ctx context.Context, req *serverv1.GetPermissionsRequest) (*serverv1.GetPermissionsResponse, error) {
+	user := auth.CtxGetUser(ctx)
+	if user == nil {
+		return nil, ErrUnauthenticated
+	}
+
+	serverID, err := parseOptionalID(req.ServerId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid server ID: %v", err)
+	}
+	channelID, err := parseOptionalID(req.ChannelId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid channel ID: %v", err)
+	}
+	if serverID == uuid.Nil && channelID == uuid.Nil {
+		return nil, status.Error(codes.InvalidArgument, "server or channel ID is required")
+	}
+
+	perms, err := s.srv.Permissions(ctx, user.ID, serverID, channelID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &serverv1.GetPermissionsResponse{
+		Permissions: uint64(perms),
+	}, nil
+}
+
+// KickMember implements serverv1.ServerServiceServer.
+func (s *ServerService) KickMember(ctx context.Context, req *serverv1.KickMemberRequest) (*serverv1.KickMemberResponse, error) {
+	user := auth.CtxGetUser(ctx)
+	if user == nil {
+		return nil, ErrUnauthenticated
+	}
+	serverID, memberID, err := parseModerationTarget(req.ServerId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.srv.KickMember(ctx, user.ID, serverID, memberID); err != nil {
+		return nil, mapModerationError(err)
+	}
+
+	return &serverv1.KickMemberResponse{}, nil
+}
+
+// BanMember implements serverv1.ServerServiceServer.
+func (s *ServerService) BanMember(ctx context.Context, req *serverv1.BanMemberRequest) (*serverv1.BanMemberResponse, error) {
+	user := auth.CtxGetUser(ctx)
+	if user == nil {
+		return nil, ErrUnauthenticated
+	}
+	serverID, targetID, err := parseModerationTarget(req.ServerId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	ban, err := s.srv.BanMember(ctx, user.ID, serverID, targetID, req.Reason, req.Duration.AsDuration())
+	if err != nil {
+		return nil, mapModerationError(err)
+	}
+
+	return &serverv1.BanMemberResponse{
+		Ban: mapBan(ban),
+	}, nil
+}
+
+// UnbanMember implements serverv1.ServerServiceServer.
+func (s *ServerService) UnbanMember(ctx context.Context, req *serverv1.UnbanMemberRequest) (*serverv1.UnbanMemberResponse, error) {
+	user := auth.CtxGetUser(ctx)
+	if user == nil {
+		return nil, ErrUnauthenticated
+	}
+	serverID, targetID, err := parseModerationTarget(req.ServerId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.srv.UnbanMember(ctx, user.ID, serverID, targetID); err != nil {
+		return nil, mapModerationError(err)
+	}
+
+	return &serverv1.UnbanMemberResponse{}, nil
+}
+
+// ListBans implements serverv1.ServerServiceServer.
+func (s *ServerService) ListBans(ctx context.Context, req *serverv1.ListBansRequest) (*serverv1.ListBansResponse, error) {
+	user := auth.CtxGetUser(ctx)
+	if user == nil {
+		return nil, ErrUnauthenticated
+	}
+	serverID, err := uuid.FromString(req.ServerId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid server ID: %v", err)
+	}
+
+	bans, err := s.srv.ListBans(ctx, user.ID, serverID)
+	if err != nil {
+		return nil, mapModerationError(err)
+	}
+
+	return &serverv1.ListBansResponse{
+		Bans: apply(bans, mapBan),
+	}, nil
+}
+
+// MuteMember implements serverv1.ServerServiceServer.
+func (s *ServerService) MuteMember(ctx context.Context, req *serverv1.MuteMemberRequest) (*serverv1.MuteMemberResponse, error) {
+	user := auth.CtxGetUser(ctx)
+	if user == nil {
+		return nil, ErrUnauthenticated
+	}
+	serverID, memberID, err := parseModerationTarget(req.ServerId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	mute, err := s.srv.MuteMember(ctx, user.ID, serverID, memberID, req.Reason, req.Duration.AsDuration())
+	if err != nil {
+		return nil, mapModerationError(err)
+	}
+
+	return &serverv1.MuteMemberResponse{
+		Mute: mapMute(mute),
+	}, nil
+}
+
+// UnmuteMember implements serverv1.ServerServiceServer.
+func (s *ServerService) UnmuteMember(ctx context.Context, req *serverv1.UnmuteMemberRequest) (*serverv1.UnmuteMemberResponse, error) {
+	user := auth.CtxGetUser(ctx)
+	if user == nil {
+		return nil, ErrUnauthenticated
+	}
+	serverID, memberID, err := parseModerationTarget(req.ServerId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.srv.UnmuteMember(ctx, user.ID, serverID, memberID); err != nil {
+		return nil, mapModerationError(err)
+	}
+
+	return &serverv1.UnmuteMemberResponse{}, nil
+}
+
+// parseModerationTarget parses the server and the user of a moderation action
+func parseModerationTarget(server, user string) (uuid.UUID, uuid.UUID, error) {
+	serverID, err := uuid.FromString(server)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, status.Errorf(codes.InvalidArgument, "invalid server ID: %v", err)
+	}
+	userID, err := uuid.FromString(user)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+	}
+	return serverID, userID, nil
+}
+
+// mapModerationError converts kick, ban and mute errors from the service to gRPC status errors
+func mapModerationError(err error) error {
+	switch {
+	case errors.Is(err, confa.ErrInvalidSanction):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, confa.ErrNotServerMember):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, confa.ErrPermissionDenied):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, sql.ErrNoRows):
+		return status.Error(codes.NotFound, "user, ban or mute not found")
+	default:
+		return err
+	}
+}
+
+// parseRoleAssignment parses the role and the member of a role assignment
+func parseRoleAssignment(role, member string) (uuid.UUID, uuid.UUID, error) {
+	roleID, err := uuid.FromString(role)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, status.Errorf(codes.InvalidArgument, "invalid role ID: %v", err)
+	}
+	memberID, err := uuid.FromString(member)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+	}
+	return roleID, memberID, nil
+}
+
+// mapRoleError converts role and permission errors from the service to gRPC status errors
+func mapRoleError(err error) error {
+	switch {
+	case errors.Is(err, confa.ErrInvalidRoleName),
+		errors.Is(err, confa.ErrInvalidPermissions),
+		errors.Is(err, confa.ErrInvalidOverwrite):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, confa.ErrEveryoneRole), errors.Is(err, confa.ErrNotServerMember):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, confa.ErrPermissionDenied):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, sql.ErrNoRows):
+		return status.Error(codes.NotFound, "role, channel or overwrite not found")
+	default:
+		return err
+	}
+}
+
+// checkPermission returns a status error when the user lacks perm in the channel, or on the server when channelID is nil
+func (s *ServerService) checkPermission(ctx context.Context, userID, serverID, channelID uuid.UUID, perm confa.Permission) error {
+	err := s.srv.CheckPermission(ctx, userID, serverID, channelID, perm)
+	if errors.Is(err, confa.ErrPermissionDenied) || errors.Is(err, confa.ErrMuted) {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	return err
+}
+
+// checkServerAccess returns a status error when the user is not a member of the server
+func (s *ServerService) checkServerAccess(ctx context.Context, userID, serverID uuid.UUID) error {
+	err := s.srv.CheckServerAccess(ctx, userID, serverID)
+	if errors.Is(err, confa.ErrPermissionDenied) {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	return err
+}
@@ -18,8 +18,9 @@ type channelRef struct {
 	ChannelID uuid.UUID
 }
 
+// The server ID is left empty for channels outside of servers.
 func parseChannelRef(ref *chatv1.TextChannelRef) (channelRef, error) {
-	serverID, err := uuid.FromString(ref.ServerId)
+	serverID, err := parseOptionalID(ref.ServerId)
 	if err != nil {
 		return channelRef{}, err
 	}
@@ -32,3 +33,21 @@ func parseChannelRef(ref *chatv1.TextChannelRef) (channelRef, error) {
 		ChannelID: channelID,
 	}, nil
 }
+
+// parseOptionalID parses an ID that may be left empty, an empty ID is returned as uuid.Nil
+func parseOptionalID(id string) (uuid.UUID, error) {
+	if id == "" {
+		return uuid.Nil, nil
+	}
+	return uuid.FromString(id)
+}
+
+// streamWriter sends everything written to it as messages of a server stream
+type streamWriter func(p []byte) error
+
+func (w streamWriter) Write(p []byte) (int, error) {
+	if err := w(p); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
@@ -3,6 +3,7 @@ package store
 import (
 	"time"
 
+	"github.com/confa-chat/node/pkg/markdown"
 	"github.com/confa-chat/node/pkg/uuid"
 	"github.com/uptrace/bun"
 )
@@ -12,14 +13,137 @@ type Server struct {
 
 	ID   uuid.UUID `bun:"id,pk"`
 	Name string    `bun:"name"`
+	// OwnerID is the user who created the server or received its ownership, nil for servers owned by the node
+	OwnerID *uuid.UUID `bun:"owner_id"`
 }
 
+// ServerMember is a user who joined a server
+type ServerMember struct {
+	bun.BaseModel `bun:"table:server_member"`
+
+	ServerID uuid.UUID `bun:"server_id,pk"`
+	UserID   uuid.UUID `bun:"user_id,pk"`
+	// Nickname replaces the username on this server, empty to show the username
+	Nickname string    `bun:"nickname,nullzero"`
+	JoinedAt time.Time `bun:"joined_at"`
+
+	User *User `bun:"rel:belongs-to,join:user_id=id"`
+	// RoleIDs are the roles assigned to the member, without the @everyone role
+	RoleIDs []uuid.UUID `bun:"-"`
+}
+
+// Role grants permissions to the members of a server it is assigned to.
+// Every server has an @everyone role with the ID of the server which applies to all members.
+type Role struct {
+	bun.BaseModel `bun:"table:server_role"`
+
+	ID          uuid.UUID `bun:"id,pk"`
+	ServerID    uuid.UUID `bun:"server_id"`
+	Name        string    `bun:"name"`
+	Permissions int64     `bun:"permissions"`
+	CreatedAt   time.Time `bun:"created_at"`
+}
+
+// MemberRole assigns a role to a member of a server
+type MemberRole struct {
+	bun.BaseModel `bun:"table:member_role"`
+
+	ServerID uuid.UUID `bun:"server_id,pk"`
+	UserID   uuid.UUID `bun:"user_id,pk"`
+	RoleID   uuid.UUID `bun:"role_id,pk"`
+}
+
+const (
+	// OverwriteTargetRole overwrites the permissions of a role in a channel
+	OverwriteTargetRole = "role"
+	// OverwriteTargetMember overwrites the permissions of a single member in a channel
+	OverwriteTargetMember = "member"
+)
+
+// ChannelOverwrite allows or denies permissions to a role or a member in a single channel
+type ChannelOverwrite struct {
+	bun.BaseModel `bun:"table:channel_overwrite"`
+
+	ChannelID  uuid.UUID `bun:"channel_id,pk"`
+	TargetType string    `bun:"target_type"`
+	TargetID   uuid.UUID `bun:"target_id,pk"`
+	Allow      int64     `bun:"allow"`
+	Deny       int64     `bun:"deny"`
+}
+
+// ServerInvite is a code which lets users join a server
+type ServerInvite struct {
+	bun.BaseModel `bun:"table:server_invite"`
+
+	Code     string    `bun:"code,pk"`
+	ServerID uuid.UUID `bun:"server_id"`
+	// ChannelID is the channel shown to users joining with the invite, none when nil
+	ChannelID *uuid.UUID `bun:"channel_id"`
+	CreatorID uuid.UUID  `bun:"creator_id"`
+	// MaxUses is the number of times the invite can be redeemed, 0 for unlimited
+	MaxUses   int        `bun:"max_uses"`
+	Uses      int        `bun:"uses"`
+	ExpiresAt *time.Time `bun:"expires_at"`
+	RevokedAt *time.Time `bun:"revoked_at"`
+	CreatedAt time.Time  `bun:"created_at"`
+}
+
+// ServerBan keeps a user out of a server until it expires or is lifted
+type ServerBan struct {
+	bun.BaseModel `bun:"table:server_ban"`
+
+	ServerID    uuid.UUID  `bun:"server_id,pk"`
+	UserID      uuid.UUID  `bun:"user_id,pk"`
+	ModeratorID *uuid.UUID `bun:"moderator_id"`
+	Reason      string     `bun:"reason"`
+	// ExpiresAt is when the ban is lifted, never when nil
+	ExpiresAt *time.Time `bun:"expires_at"`
+	CreatedAt time.Time  `bun:"created_at"`
+}
+
+// ServerMute stops a member from sending messages on a server until it expires, also after leaving and joining again
+type ServerMute struct {
+	bun.BaseModel `bun:"table:server_mute"`
+
+	ServerID    uuid.UUID  `bun:"server_id,pk"`
+	UserID      uuid.UUID  `bun:"user_id,pk"`
+	ModeratorID *uuid.UUID `bun:"moderator_id"`
+	Reason      string     `bun:"reason"`
+	ExpiresAt   time.Time  `bun:"expires_at"`
+	CreatedAt   time.Time  `bun:"created_at"`
+}
+
+const (
+	ChannelKindServer = "server"
+	// ChannelKindDirect is a one-to-one conversation outside of any server
+	ChannelKindDirect = "direct"
+	// ChannelKindGroup is a named conversation of a few users outside of any server
+	ChannelKindGroup = "group"
+)
+
 type TextChannel struct {
 	bun.BaseModel `bun:"table:text_channel"`
 
-	ID       uuid.UUID `bun:"id,pk"`
-	ServerID uuid.UUID `bun:"server_id"`
-	Name     string    `bun:"name"`
+	ID uuid.UUID `bun:"id,pk"`
+	// ServerID is nil for channels which don't belong to a server, see Kind
+	ServerID *uuid.UUID `bun:"server_id"`
+	Name     string     `bun:"name"`
+	Kind     string     `bun:"kind"`
+	// DirectKey identifies the pair of participants of a direct channel
+	DirectKey *string `bun:"direct_key"`
+	// OwnerID is the member of a group who can remove other members
+	OwnerID *uuid.UUID `bun:"owner_id"`
+	// MessageTTLSeconds is how long messages are kept in the channel, nil keeps them forever
+	MessageTTLSeconds *int `bun:"message_ttl_seconds"`
+}
+
+// ChannelMember is a participant of a channel which doesn't belong to a server
+type ChannelMember struct {
+	bun.BaseModel `bun:"table:channel_member"`
+
+	ChannelID uuid.UUID `bun:"channel_id,pk"`
+	UserID    uuid.UUID `bun:"user_id,pk"`
+	JoinedAt  time.Time `bun:"joined_at"`
 }
 
 type MessageAttachment struct {
@@ -36,12 +160,158 @@ type Message struct {
 
 	ID uuid.UUID `bun:"id,pk"`
 	// ServerID  uuid.UUID `bun:"server_id"`
-	ChannelID uuid.UUID `bun:"channel_id"`
-	SenderID  uuid.UUID `bun:"sender_id"`
+	ChannelID uuid.UUID  `bun:"channel_id"`
+	SenderID  uuid.UUID  `bun:"sender_id"`
+	Content   string     `bun:"content"`
+	Timestamp time.Time  `bun:"timestamp"`
+	EditedAt  *time.Time `bun:"edited_at"`
+	// DeletedAt is set when the message was deleted, the row is kept as a tombstone
+	DeletedAt *time.Time `bun:"deleted_at"`
+	// ParentID is the message this one replies to, replies are kept out of the channel history
+	ParentID   *uuid.UUID `bun:"parent_id"`
+	ReplyCount int        `bun:"reply_count"`
+	// ExpiresAt is set when the message was sent with its own TTL
+	ExpiresAt *time.Time `bun:"expires_at"`
+	// ContentNodes is the sanitized markdown tree of Content, empty for messages stored before it was introduced
+	ContentNodes []markdown.Node `bun:"content_nodes,type:jsonb,nullzero"`
+	// SystemEvent is set for messages posted by the node about a change of the channel, SenderID is the user who made it
+	SystemEvent *SystemEvent `bun:"system_event,type:jsonb"`
+
+	Attachments []MessageAttachment `bun:"rel:has-many,join:id=message_id"`
+	Previews    []LinkPreview       `bun:"rel:has-many,join:id=message_id"`
+	// Reactions are aggregated for a specific viewer, see confa.Service.LoadReactions
+	Reactions []ReactionCount `bun:"-"`
+}
+
+const (
+	SystemEventGroupCreated  = "group_created"
+	SystemEventMembersAdded  = "members_added"
+	SystemEventMemberRemoved = "member_removed"
+	SystemEventMemberLeft    = "member_left"
+	SystemEventGroupRenamed  = "group_renamed"
+)
+
+// SystemEvent describes a change of a channel shown in its history
+type SystemEvent struct {
+	Type string `json:"type"`
+	// UserIDs are the users the change is about, like the added members
+	UserIDs []uuid.UUID `json:"user_ids,omitempty"`
+	// Name is the new name of a renamed group
+	Name string `json:"name,omitempty"`
+}
+
+// LinkPreview is a card shown under a message for a link in its content
+type LinkPreview struct {
+	bun.BaseModel `bun:"table:link_preview"`
+
+	ID          uuid.UUID `bun:"id,pk"`
+	MessageID   uuid.UUID `bun:"message_id"`
+	Position    int       `bun:"position"`
+	URL         string    `bun:"url"`
+	Title       string    `bun:"title"`
+	Description string    `bun:"description"`
+	SiteName    string    `bun:"site_name"`
+	// ThumbnailID is the thumbnail in the attachment storage, nil if the page has no image
+	ThumbnailID   *uuid.UUID `bun:"thumbnail_id"`
+	ThumbnailName string     `bun:"thumbnail_name"`
+}
+
+type MessageReaction struct {
+	bun.BaseModel `bun:"table:message_reaction"`
+
+	MessageID uuid.UUID `bun:"message_id,pk"`
+	UserID    uuid.UUID `bun:"user_id,pk"`
+	Emoji     string    `bun:"emoji,pk"`
+	CreatedAt time.Time `bun:"created_at"`
+}
+
+// ReactionCount is the number of users who reacted to a message with an emoji
+type ReactionCount struct {
+	MessageID   uuid.UUID `bun:"message_id"`
+	Emoji       string    `bun:"emoji"`
+	Count       int       `bun:"count"`
+	ReactedByMe bool      `bun:"reacted_by_me"`
+}
+
+// MessageRevision keeps the content a message had before it was edited
+type MessageRevision struct {
+	bun.BaseModel `bun:"table:message_revision"`
+
+	ID        uuid.UUID `bun:"id,pk"`
+	MessageID uuid.UUID `bun:"message_id"`
 	Content   string    `bun:"content"`
 	Timestamp time.Time `bun:"timestamp"`
+}
 
-	Attachments []MessageAttachment `bun:"rel:has-many,join:id=message_id"`
+const (
+	MentionKindUser     = "user"
+	MentionKindEveryone = "everyone"
+	MentionKindChannel  = "channel"
+)
+
+// Mention notifies a user that a message addressed them
+type Mention struct {
+	bun.BaseModel `bun:"table:mention"`
+
+	ID             uuid.UUID  `bun:"id,pk"`
+	MessageID      uuid.UUID  `bun:"message_id"`
+	UserID         uuid.UUID  `bun:"user_id"`
+	ChannelID      uuid.UUID  `bun:"channel_id"`
+	Kind           string     `bun:"kind"`
+	CreatedAt      time.Time  `bun:"created_at"`
+	AcknowledgedAt *time.Time `bun:"acknowledged_at"`
+
+	Channel *TextChannel `bun:"rel:belongs-to,join:channel_id=id"`
+	Message *Message     `bun:"-"`
+}
+
+// PinnedMessage marks a message pinned to its channel
+type PinnedMessage struct {
+	bun.BaseModel `bun:"table:pinned_message"`
+
+	ChannelID uuid.UUID  `bun:"channel_id,pk"`
+	MessageID uuid.UUID  `bun:"message_id,pk"`
+	PinnedBy  *uuid.UUID `bun:"pinned_by"`
+	PinnedAt  time.Time  `bun:"pinned_at"`
+
+	Message *Message `bun:"-"`
+}
+
+// ReadState is the last message of a channel the user has read
+type ReadState struct {
+	bun.BaseModel `bun:"table:read_state"`
+
+	UserID            uuid.UUID `bun:"user_id,pk"`
+	ChannelID         uuid.UUID `bun:"channel_id,pk"`
+	LastReadMessageID uuid.UUID `bun:"last_read_message_id"`
+	UpdatedAt         time.Time `bun:"updated_at"`
+}
+
+// ScheduledMessage is a message posted by the node at SendAt
+type ScheduledMessage struct {
+	bun.BaseModel `bun:"table:scheduled_message"`
+
+	ID uuid.UUID `bun:"id,pk"`
+	// ServerID is nil for messages scheduled in direct and group channels
+	ServerID    *uuid.UUID            `bun:"server_id"`
+	ChannelID   uuid.UUID             `bun:"channel_id"`
+	SenderID    uuid.UUID             `bun:"sender_id"`
+	ReplyToID   *uuid.UUID            `bun:"reply_to_id"`
+	Content     string                `bun:"content"`
+	Attachments []ScheduledAttachment `bun:"attachments,type:jsonb"`
+	SendAt      time.Time             `bun:"send_at"`
+	CreatedAt   time.Time             `bun:"created_at"`
+	// MessageID is assigned when the delivery starts, so a retried delivery can't post the message twice
+	MessageID *uuid.UUID `bun:"message_id"`
+	// LockedUntil is the end of the lease of the node delivering the message
+	LockedUntil *time.Time `bun:"locked_until"`
+	Attempts    int        `bun:"attempts"`
+}
+
+// ScheduledAttachment is an attachment posted with a scheduled message
+type ScheduledAttachment struct {
+	ID   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
 }
 
 type VoiceChannel struct {
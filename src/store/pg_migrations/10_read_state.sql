@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS read_state (
+    user_id UUID NOT NULL REFERENCES "user"(id) ON DELETE CASCADE,
+    channel_id UUID NOT NULL REFERENCES text_channel(id) ON DELETE CASCADE,
+    last_read_message_id UUID NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (user_id, channel_id)
+);
+-- +goose StatementEnd
@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS pinned_message (
+    channel_id UUID NOT NULL REFERENCES text_channel(id) ON DELETE CASCADE,
+    message_id UUID NOT NULL REFERENCES message(id) ON DELETE CASCADE,
+    pinned_by UUID REFERENCES "user"(id) ON DELETE SET NULL,
+    pinned_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (channel_id, message_id)
+);
+-- +goose StatementEnd
@@ -0,0 +1,4 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE message ADD COLUMN IF NOT EXISTS content_nodes JSONB;
+-- +goose StatementEnd
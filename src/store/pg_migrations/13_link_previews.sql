@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS link_preview (
+    id UUID PRIMARY KEY,
+    message_id UUID NOT NULL REFERENCES message(id) ON DELETE CASCADE,
+    position INT NOT NULL,
+    url TEXT NOT NULL,
+    title TEXT NOT NULL DEFAULT '',
+    description TEXT NOT NULL DEFAULT '',
+    site_name TEXT NOT NULL DEFAULT '',
+    thumbnail_id UUID,
+    thumbnail_name TEXT NOT NULL DEFAULT ''
+);
+CREATE INDEX IF NOT EXISTS link_preview_message_id ON link_preview (message_id);
+-- +goose StatementEnd
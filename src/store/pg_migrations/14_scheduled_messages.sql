@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS scheduled_message (
+    id UUID PRIMARY KEY,
+    server_id UUID NOT NULL REFERENCES "server"(id) ON DELETE CASCADE,
+    channel_id UUID NOT NULL REFERENCES text_channel(id) ON DELETE CASCADE,
+    sender_id UUID NOT NULL REFERENCES "user"(id) ON DELETE CASCADE,
+    reply_to_id UUID REFERENCES message(id) ON DELETE CASCADE,
+    content TEXT NOT NULL,
+    attachments JSONB NOT NULL DEFAULT '[]',
+    send_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    message_id UUID,
+    locked_until TIMESTAMPTZ,
+    attempts INT NOT NULL DEFAULT 0
+);
+CREATE INDEX IF NOT EXISTS scheduled_message_send_at ON scheduled_message (send_at);
+CREATE INDEX IF NOT EXISTS scheduled_message_sender_id ON scheduled_message (sender_id, send_at);
+-- +goose StatementEnd
@@ -0,0 +1,6 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE text_channel ADD COLUMN IF NOT EXISTS message_ttl_seconds INT;
+ALTER TABLE message ADD COLUMN IF NOT EXISTS expires_at TIMESTAMPTZ;
+CREATE INDEX IF NOT EXISTS message_expires_at ON message (expires_at) WHERE expires_at IS NOT NULL;
+-- +goose StatementEnd
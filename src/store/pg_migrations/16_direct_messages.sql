@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE text_channel ADD COLUMN IF NOT EXISTS kind VARCHAR(16) NOT NULL DEFAULT 'server';
+ALTER TABLE text_channel ALTER COLUMN server_id DROP NOT NULL;
+ALTER TABLE text_channel ADD CONSTRAINT text_channel_server_kind CHECK ((kind = 'server') = (server_id IS NOT NULL));
+-- direct_key is the sorted pair of participants, so each pair of users has a single direct channel
+ALTER TABLE text_channel ADD COLUMN IF NOT EXISTS direct_key VARCHAR(80) UNIQUE;
+CREATE TABLE IF NOT EXISTS channel_member (
+    channel_id UUID NOT NULL REFERENCES text_channel(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES "user"(id) ON DELETE CASCADE,
+    joined_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (channel_id, user_id)
+);
+CREATE INDEX IF NOT EXISTS channel_member_user_id ON channel_member (user_id);
+-- +goose StatementEnd
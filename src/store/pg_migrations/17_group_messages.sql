@@ -0,0 +1,5 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE text_channel ADD COLUMN IF NOT EXISTS owner_id UUID REFERENCES "user"(id) ON DELETE SET NULL;
+ALTER TABLE message ADD COLUMN IF NOT EXISTS system_event JSONB;
+-- +goose StatementEnd
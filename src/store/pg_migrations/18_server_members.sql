@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS server_member (
+    server_id UUID NOT NULL REFERENCES "server"(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES "user"(id) ON DELETE CASCADE,
+    nickname VARCHAR(64),
+    joined_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (server_id, user_id)
+);
+CREATE INDEX IF NOT EXISTS server_member_user_id ON server_member (user_id);
+-- Every server used to be visible to every user. Existing users join the default server new users join,
+-- and the servers where they posted, the other servers stay hidden until they get an invite.
+INSERT INTO server_member (server_id, user_id)
+SELECT "server".id, "user".id FROM "server" CROSS JOIN "user"
+WHERE "server"."name" = 'confach'
+ON CONFLICT DO NOTHING;
+INSERT INTO server_member (server_id, user_id)
+SELECT DISTINCT text_channel.server_id, message.sender_id
+FROM message
+JOIN text_channel ON text_channel.id = message.channel_id
+WHERE text_channel.server_id IS NOT NULL
+ON CONFLICT DO NOTHING;
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS server_invite (
+    code VARCHAR(16) PRIMARY KEY,
+    server_id UUID NOT NULL REFERENCES "server"(id) ON DELETE CASCADE,
+    channel_id UUID REFERENCES text_channel(id) ON DELETE SET NULL,
+    creator_id UUID NOT NULL REFERENCES "user"(id) ON DELETE CASCADE,
+    max_uses INT NOT NULL DEFAULT 0,
+    uses INT NOT NULL DEFAULT 0,
+    expires_at TIMESTAMPTZ,
+    revoked_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS server_invite_server_id ON server_invite (server_id, created_at);
+-- +goose StatementEnd
@@ -0,0 +1,5 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "server" ADD COLUMN IF NOT EXISTS owner_id UUID REFERENCES "user"(id) ON DELETE SET NULL;
+CREATE INDEX IF NOT EXISTS server_owner_id ON "server" (owner_id);
+-- +goose StatementEnd
@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS server_role (
+    id UUID PRIMARY KEY,
+    server_id UUID NOT NULL REFERENCES "server"(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    permissions BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS server_role_server_id ON server_role (server_id, created_at);
+CREATE TABLE IF NOT EXISTS member_role (
+    server_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    role_id UUID NOT NULL REFERENCES server_role(id) ON DELETE CASCADE,
+    PRIMARY KEY (server_id, user_id, role_id),
+    FOREIGN KEY (server_id, user_id) REFERENCES server_member(server_id, user_id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS member_role_role_id ON member_role (role_id);
+CREATE TABLE IF NOT EXISTS channel_overwrite (
+    channel_id UUID NOT NULL REFERENCES text_channel(id) ON DELETE CASCADE,
+    target_type VARCHAR(16) NOT NULL,
+    target_id UUID NOT NULL,
+    allow BIGINT NOT NULL DEFAULT 0,
+    deny BIGINT NOT NULL DEFAULT 0,
+    PRIMARY KEY (channel_id, target_id)
+);
+-- The @everyone role of a server shares its ID, 15 is confa.DefaultPermissions:
+-- view channels, send messages, add reactions and create invites
+INSERT INTO server_role (id, server_id, name, permissions)
+SELECT id, id, '@everyone', 15 FROM "server"
+ON CONFLICT DO NOTHING;
+-- +goose StatementEnd
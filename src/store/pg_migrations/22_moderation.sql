@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS server_ban (
+    server_id UUID NOT NULL REFERENCES "server"(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES "user"(id) ON DELETE CASCADE,
+    moderator_id UUID REFERENCES "user"(id) ON DELETE SET NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    expires_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (server_id, user_id)
+);
+CREATE TABLE IF NOT EXISTS server_mute (
+    server_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    moderator_id UUID REFERENCES "user"(id) ON DELETE SET NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (server_id, user_id),
+    FOREIGN KEY (server_id, user_id) REFERENCES server_member(server_id, user_id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS server_ban_expires_at ON server_ban (expires_at) WHERE expires_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS server_mute_expires_at ON server_mute (expires_at);
+-- +goose StatementEnd
@@ -0,0 +1,7 @@
+-- +goose Up
+-- +goose StatementBegin
+-- A mute must survive leaving and joining the server again, like a ban
+ALTER TABLE server_mute DROP CONSTRAINT IF EXISTS server_mute_server_id_user_id_fkey;
+ALTER TABLE server_mute ADD FOREIGN KEY (server_id) REFERENCES "server"(id) ON DELETE CASCADE;
+ALTER TABLE server_mute ADD FOREIGN KEY (user_id) REFERENCES "user"(id) ON DELETE CASCADE;
+-- +goose StatementEnd
@@ -0,0 +1,5 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Messages scheduled in direct and group channels have no server
+ALTER TABLE scheduled_message ALTER COLUMN server_id DROP NOT NULL;
+-- +goose StatementEnd
@@ -0,0 +1,5 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Pages of the channel history are read in (timestamp, id) order
+CREATE INDEX IF NOT EXISTS idx_message_channel_history ON message(channel_id, "timestamp", id) WHERE parent_id IS NULL;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "message" ADD COLUMN edited_at TIMESTAMPTZ;
+CREATE TABLE IF NOT EXISTS message_revision (
+    id UUID PRIMARY KEY,
+    message_id UUID NOT NULL REFERENCES message(id) ON DELETE CASCADE,
+    content TEXT NOT NULL,
+    "timestamp" TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS idx_message_revision_message_id ON message_revision(message_id);
+-- +goose StatementEnd
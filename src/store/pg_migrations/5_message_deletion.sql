@@ -0,0 +1,8 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "message" ADD COLUMN deleted_at TIMESTAMPTZ;
+ALTER TABLE message_attachment
+    DROP CONSTRAINT IF EXISTS message_attachment_message_id_fkey,
+    ADD CONSTRAINT message_attachment_message_id_fkey FOREIGN KEY (message_id) REFERENCES message(id) ON DELETE CASCADE;
+CREATE INDEX IF NOT EXISTS idx_message_attachment_attachment_id ON message_attachment(attachment_id);
+-- +goose StatementEnd
@@ -0,0 +1,7 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "message"
+    ADD COLUMN parent_id UUID REFERENCES message(id) ON DELETE CASCADE,
+    ADD COLUMN reply_count INT NOT NULL DEFAULT 0;
+CREATE INDEX IF NOT EXISTS idx_message_parent_id ON message(parent_id) WHERE parent_id IS NOT NULL;
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS mention (
+    id UUID PRIMARY KEY,
+    message_id UUID NOT NULL REFERENCES message(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES "user"(id) ON DELETE CASCADE,
+    channel_id UUID NOT NULL REFERENCES text_channel(id) ON DELETE CASCADE,
+    kind TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    acknowledged_at TIMESTAMPTZ
+);
+CREATE UNIQUE INDEX IF NOT EXISTS unique_mention_message_user ON mention(message_id, user_id);
+CREATE INDEX IF NOT EXISTS idx_mention_user_id ON mention(user_id, id);
+-- +goose StatementEnd